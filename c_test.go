@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// TestCGolden reads and writes the golden frames with the c target, compiled with -Wall -Werror.
+// Without a C compiler it is skipped; TestGolden still pins the bytes the c target has to match.
+func TestCGolden(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("cc not found")
+	}
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := filepath.Join(dir, "golden.c")
+			generate(t, "-t", "c", "-i", "testdata/golden/schema.yaml", "-o", src, "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+
+			check := filepath.Join(dir, "check")
+			cmd := exec.Command(cc, "-std=c99", "-Wall", "-Werror", "-I", dir, "-o", check,
+				filepath.Join("testdata", "c", "check.c"), src)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+
+			golden := filepath.Join("testdata", "golden", name)
+			out, err := exec.Command(check, filepath.Join(golden, "frames.bin")).Output()
+			if err != nil {
+				if e, ok := err.(*exec.ExitError); ok {
+					t.Fatalf("%v\n%s", err, e.Stderr)
+				}
+				t.Fatal(err)
+			}
+			lines, err := ioutil.ReadFile(filepath.Join(golden, "frames.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n, want := string(bytes.TrimSpace(out)), strconv.Itoa(bytes.Count(lines, []byte("\n"))); n != want {
+				t.Errorf("got %v frames, want %v", n, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/paidgeek/bufobjects/dynamic"
+	"gopkg.in/yaml.v2"
+)
+
+func encodeMain(args []string) {
+	c := newSubcommand("encode", "[file]")
+	formatFlag := c.fs.String("f", "json", "input format (json or yaml)")
+	outFlag := c.fs.String("o", "", "output file (default stdout)")
+	c.load(args)
+	cd, err := dynamic.NewCodec(doc)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	values, err := readDocuments(*formatFlag, c.input())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var out []byte
+	for i, v := range values {
+		o, err := cd.FromValue(v)
+		if err == nil {
+			out, err = cd.Append(out, o)
+		}
+		if err != nil {
+			log.Fatalf("document %d: %v\n", i, err)
+		}
+	}
+
+	writeOutput(*outFlag, out)
+}
+
+func decodeMain(args []string) {
+	c := newSubcommand("decode", "[file]")
+	formatFlag := c.fs.String("f", "json", "output format (json or yaml)")
+	outFlag := c.fs.String("o", "", "output file (default stdout)")
+	c.load(args)
+	cd, err := dynamic.NewCodec(doc)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *formatFlag != "json" && *formatFlag != "yaml" {
+		log.Fatalln("format must be json or yaml")
+	}
+
+	data := c.input()
+	var out []byte
+	for off := 0; off < len(data); {
+		o, n, err := cd.Decode(data[off:])
+		if err != nil {
+			writeOutput(*outFlag, out)
+			log.Fatalf("frame at offset %d: %v\n", off, err)
+		}
+		off += n
+
+		if *formatFlag == "json" {
+			b, _ := o.MarshalJSON()
+			out = append(append(out, b...), '\n')
+			continue
+		}
+		if len(out) > 0 {
+			out = append(out, "---\n"...)
+		}
+		b, err := yaml.Marshal(o)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		out = append(out, b...)
+	}
+
+	writeOutput(*outFlag, out)
+}
+
+// readDocuments reads every JSON value or YAML document in data. Top-level lists are flattened,
+// so a list of objects encodes to consecutive frames.
+func readDocuments(format string, data []byte) ([]interface{}, error) {
+	var next func(v *interface{}) error
+	switch format {
+	case "json":
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		next = func(v *interface{}) error {
+			return d.Decode(v)
+		}
+	case "yaml":
+		d := yaml.NewDecoder(bytes.NewReader(data))
+		next = func(v *interface{}) error {
+			return d.Decode(v)
+		}
+	default:
+		return nil, errors.New("format must be json or yaml")
+	}
+
+	values := []interface{}{}
+	for {
+		var v interface{}
+		err := next(&v)
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if list, ok := v.([]interface{}); ok {
+			values = append(values, list...)
+		} else if v != nil {
+			values = append(values, v)
+		}
+	}
+}
+
+func writeOutput(path string, data []byte) {
+	var err error
+	if path == "" {
+		_, err = os.Stdout.Write(data)
+	} else {
+		err = ioutil.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestCSharpGolden reads and writes the golden frames with the csharp target, built with
+// warnings as errors. Without dotnet it is skipped; TestGolden still pins the bytes the csharp
+// target has to match.
+func TestCSharpGolden(t *testing.T) {
+	dotnet, err := exec.LookPath("dotnet")
+	if err != nil {
+		t.Skip("dotnet not found")
+	}
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			generate(t, "-t", "csharp", "-i", "testdata/golden/schema.yaml", "-o", filepath.Join(dir, "Golden.cs"), "-p", "Golden", "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+			for _, f := range []string{"check.csproj", "Check.cs"} {
+				b, err := ioutil.ReadFile(filepath.Join("testdata", "csharp", f))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err = ioutil.WriteFile(filepath.Join(dir, f), b, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			out := filepath.Join(dir, "out")
+			cmd := exec.Command(dotnet, "build", "-o", out, filepath.Join(dir, "check.csproj"))
+			cmd.Env = append(os.Environ(), "DOTNET_NOLOGO=1", "DOTNET_CLI_TELEMETRY_OPTOUT=1")
+			if b, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, b)
+			}
+
+			golden := filepath.Join("testdata", "golden", name)
+			cmd = exec.Command(dotnet, filepath.Join(out, "check.dll"), filepath.Join(golden, "frames.bin"), filepath.Join(golden, "frames.json"))
+			if b, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, b)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Package dynamic encodes and decodes bufobjects frames at runtime from a parsed schema,
+// for tools that handle messages they were not compiled against.
+package dynamic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+var (
+	ErrTruncated = errors.New("truncated frame")
+	ErrUnknownId = errors.New("unknown object id")
+)
+
+// Codec reads and writes frames with the same wire rules as the generated
+// Write<Interface>At and Read<Interface>At functions.
+type Codec struct {
+	doc   *schema.Document
+	order binary.ByteOrder
+}
+
+// NewCodec returns a codec for the document's objects, byte order and int size.
+func NewCodec(doc *schema.Document) (*Codec, error) {
+	c := &Codec{doc: doc}
+	switch doc.ByteOrder {
+	case "", "little":
+		c.order = binary.LittleEndian
+	case "big":
+		c.order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("unknown byte order %v", doc.ByteOrder)
+	}
+	if doc.IntSize != 32 && doc.IntSize != 64 {
+		return nil, fmt.Errorf("int size must be 32 or 64, got %v", doc.IntSize)
+	}
+
+	return c, nil
+}
+
+// Decode decodes the frame at the start of data and returns it with the frame length.
+func (c *Codec) Decode(data []byte) (*Object, int, error) {
+	if len(data) < 2 {
+		return nil, 0, ErrTruncated
+	}
+	id := c.order.Uint16(data)
+	obj := c.doc.ObjectForId(id)
+	if obj == nil {
+		return nil, 0, fmt.Errorf("%v: %v", ErrUnknownId, id)
+	}
+
+	off := 2
+	if obj.IsVariableSize {
+		if len(data) < 4 {
+			return nil, 0, ErrTruncated
+		}
+		size := int(c.order.Uint16(data[2:]))
+		if len(data) < 4 + size {
+			return nil, 0, ErrTruncated
+		}
+		data = data[:4 + size]
+		off = 4
+	}
+
+	o, off, err := c.decodeObject(obj, data, off)
+	if err != nil {
+		return nil, 0, err
+	}
+	if obj.IsVariableSize && off != len(data) {
+		return nil, 0, fmt.Errorf("%v: size header is %d bytes, body is %d", obj.Name, len(data) - 4, off - 4)
+	}
+
+	return o, off, nil
+}
+
+func (c *Codec) decodeObject(obj *schema.Object, data []byte, off int) (*Object, int, error) {
+	o := New(obj)
+	for _, f := range obj.Fields {
+		var v interface{}
+		var err error
+		if f.IsArray || f.IsSlice {
+			n := f.ArraySize
+			if f.IsSlice {
+				if off + 2 > len(data) {
+					return nil, off, ErrTruncated
+				}
+				n = int(c.order.Uint16(data[off:]))
+				off += 2
+			}
+			list := make([]interface{}, n)
+			for i := range list {
+				if list[i], off, err = c.decodeValue(f.Type, data, off); err != nil {
+					return nil, off, err
+				}
+			}
+			v = list
+		} else if v, off, err = c.decodeValue(f.Type, data, off); err != nil {
+			return nil, off, err
+		}
+		o.Fields[f.Name] = v
+	}
+
+	return o, off, nil
+}
+
+func (c *Codec) decodeValue(t string, data []byte, off int) (interface{}, int, error) {
+	if schema.IsObjectType(t) {
+		return c.decodeObject(c.doc.ObjectForType(t), data, off)
+	}
+
+	if t == "string" {
+		if off + 2 > len(data) {
+			return nil, off, ErrTruncated
+		}
+		n := int(c.order.Uint16(data[off:]))
+		off += 2
+		if off + n > len(data) {
+			return nil, off, ErrTruncated
+		}
+		return string(data[off:off + n]), off + n, nil
+	}
+
+	size := c.doc.SizeOf(t)
+	if off + size > len(data) {
+		return nil, off, ErrTruncated
+	}
+	bits := c.uint(data[off:off + size])
+	off += size
+
+	switch t {
+	case "bool":
+		return bits == 1, off, nil
+	case "float32":
+		return math.Float32frombits(uint32(bits)), off, nil
+	case "float64":
+		return math.Float64frombits(bits), off, nil
+	case "int8":
+		return int8(bits), off, nil
+	case "int16":
+		return int16(bits), off, nil
+	case "int32":
+		return int32(bits), off, nil
+	case "int", "int64":
+		shift := uint(64 - size * 8)
+		return int64(bits << shift) >> shift, off, nil
+	case "byte", "uint8":
+		return uint8(bits), off, nil
+	case "uint16":
+		return uint16(bits), off, nil
+	case "uint32":
+		return uint32(bits), off, nil
+	}
+
+	return bits, off, nil
+}
+
+func (c *Codec) uint(b []byte) uint64 {
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(c.order.Uint16(b))
+	case 4:
+		return uint64(c.order.Uint32(b))
+	}
+
+	return c.order.Uint64(b)
+}
+
+// Encode returns the frame for o.
+func (c *Codec) Encode(o *Object) ([]byte, error) {
+	return c.Append(nil, o)
+}
+
+// Append appends the frame for o to b.
+func (c *Codec) Append(b []byte, o *Object) ([]byte, error) {
+	obj := o.Schema
+	start := len(b)
+	b = c.appendUint(b, uint64(obj.Id), 2)
+	if obj.IsVariableSize {
+		b = append(b, 0, 0)
+	}
+
+	body := len(b)
+	b, err := c.encodeObject(b, obj, o, obj.Name)
+	if err != nil {
+		return nil, err
+	}
+	if obj.IsVariableSize {
+		size := len(b) - body
+		if size > math.MaxUint16 {
+			return nil, fmt.Errorf("%v: body is %d bytes, more than a frame can hold", obj.Name, size)
+		}
+		c.order.PutUint16(b[start + 2:], uint16(size))
+	}
+
+	return b, nil
+}
+
+// encodeObject appends the body of o. Missing fields and a nil o are written as zero values.
+func (c *Codec) encodeObject(b []byte, obj *schema.Object, o *Object, path string) ([]byte, error) {
+	if o != nil && o.Schema != obj {
+		return nil, fmt.Errorf("%v: expected %v, got %v", path, obj.Name, o.Schema.Name)
+	}
+
+	var err error
+	for _, f := range obj.Fields {
+		var v interface{}
+		if o != nil {
+			v = o.Fields[f.Name]
+		}
+		p := path + "." + f.Name
+		if f.IsArray || f.IsSlice {
+			var list []interface{}
+			if v != nil {
+				l, ok := v.([]interface{})
+				if !ok {
+					return nil, fmt.Errorf("%v: expected []interface{}, got %T", p, v)
+				}
+				list = l
+			}
+			n := len(list)
+			if f.IsArray {
+				if n > f.ArraySize {
+					return nil, fmt.Errorf("%v: too many elements", p)
+				}
+				n = f.ArraySize
+			} else {
+				if n > math.MaxUint16 {
+					return nil, fmt.Errorf("%v: too many elements", p)
+				}
+				b = c.appendUint(b, uint64(n), 2)
+			}
+			for i := 0; i < n; i++ {
+				var e interface{}
+				if i < len(list) {
+					e = list[i]
+				}
+				if b, err = c.encodeValue(b, f.Type, e, indexPath(p, i)); err != nil {
+					return nil, err
+				}
+			}
+		} else if b, err = c.encodeValue(b, f.Type, v, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+func (c *Codec) encodeValue(b []byte, t string, v interface{}, path string) ([]byte, error) {
+	if schema.IsObjectType(t) {
+		o, ok := v.(*Object)
+		if v != nil && !ok {
+			return nil, fmt.Errorf("%v: expected *dynamic.Object, got %T", path, v)
+		}
+		return c.encodeObject(b, c.doc.ObjectForType(t), o, path)
+	}
+
+	if v == nil {
+		v = zero(t)
+	}
+
+	var bits uint64
+	ok := true
+	switch t {
+	case "string":
+		var s string
+		if s, ok = v.(string); !ok {
+			break
+		}
+		if len(s) > math.MaxUint16 {
+			return nil, fmt.Errorf("%v: string is too long", path)
+		}
+		b = c.appendUint(b, uint64(len(s)), 2)
+		return append(b, s...), nil
+	case "bool":
+		var x bool
+		if x, ok = v.(bool); x {
+			bits = 1
+		}
+	case "float32":
+		var x float32
+		x, ok = v.(float32)
+		bits = uint64(math.Float32bits(x))
+	case "float64":
+		var x float64
+		x, ok = v.(float64)
+		bits = math.Float64bits(x)
+	case "int8":
+		var x int8
+		x, ok = v.(int8)
+		bits = uint64(x)
+	case "int16":
+		var x int16
+		x, ok = v.(int16)
+		bits = uint64(x)
+	case "int32":
+		var x int32
+		x, ok = v.(int32)
+		bits = uint64(x)
+	case "int", "int64":
+		var x int64
+		x, ok = v.(int64)
+		if ok && t == "int" && c.doc.IntSize == 32 && (x < math.MinInt32 || x > math.MaxInt32) {
+			return nil, fmt.Errorf("%v: %v does not fit in 32 bits", path, x)
+		}
+		bits = uint64(x)
+	case "byte", "uint8":
+		var x uint8
+		x, ok = v.(uint8)
+		bits = uint64(x)
+	case "uint16":
+		var x uint16
+		x, ok = v.(uint16)
+		bits = uint64(x)
+	case "uint32":
+		var x uint32
+		x, ok = v.(uint32)
+		bits = uint64(x)
+	case "uint", "uint64":
+		bits, ok = v.(uint64)
+		if ok && t == "uint" && c.doc.IntSize == 32 && bits > math.MaxUint32 {
+			return nil, fmt.Errorf("%v: %v does not fit in 32 bits", path, bits)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("%v: expected %v, got %T", path, goType(t), v)
+	}
+
+	return c.appendUint(b, bits, c.doc.SizeOf(t)), nil
+}
+
+func (c *Codec) appendUint(b []byte, v uint64, size int) []byte {
+	var tmp [8]byte
+	switch size {
+	case 1:
+		tmp[0] = byte(v)
+	case 2:
+		c.order.PutUint16(tmp[:], uint16(v))
+	case 4:
+		c.order.PutUint32(tmp[:], uint32(v))
+	default:
+		c.order.PutUint64(tmp[:], v)
+	}
+
+	return append(b, tmp[:size]...)
+}
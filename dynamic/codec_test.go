@@ -0,0 +1,104 @@
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+// goldenCodec returns a codec for the golden schema and the directory of its golden files,
+// which TestGolden in the main package writes with generated Go code.
+func goldenCodec(t *testing.T, endian string, intSize int) (*Codec, string) {
+	t.Helper()
+	doc := &schema.Document{ByteOrder:endian, IntSize:intSize}
+	if err := doc.ParseFiles([]string{"../testdata/golden/schema.yaml"}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCodec(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, filepath.Join("..", "testdata", "golden", fmt.Sprintf("%v-%v", endian, intSize))
+}
+
+func readGolden(t *testing.T, dir string, name string) []byte {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// sameJSON reports whether a and b hold the same JSON values, with numbers compared as written.
+func sameJSON(a []byte, b []byte) bool {
+	var va, vb interface{}
+	da := json.NewDecoder(bytes.NewReader(a))
+	da.UseNumber()
+	db := json.NewDecoder(bytes.NewReader(b))
+	db.UseNumber()
+	return da.Decode(&va) == nil && db.Decode(&vb) == nil && reflect.DeepEqual(va, vb)
+}
+
+func TestGolden(t *testing.T) {
+	for _, endian := range []string{"little", "big"} {
+		for _, intSize := range []int{32, 64} {
+			t.Run(fmt.Sprintf("%v-%v", endian, intSize), func(t *testing.T) {
+				c, dir := goldenCodec(t, endian, intSize)
+				frames := readGolden(t, dir, "frames.bin")
+				lines := bytes.Split(bytes.TrimSuffix(readGolden(t, dir, "frames.json"), []byte("\n")), []byte("\n"))
+
+				i := 0
+				for ; len(frames) > 0; i++ {
+					if i >= len(lines) {
+						t.Fatalf("more frames than JSON lines")
+					}
+					o, n, err := c.Decode(frames)
+					if err != nil {
+						t.Fatalf("frame %v: %v", i, err)
+					}
+					frame := frames[:n]
+					frames = frames[n:]
+
+					if b, err := c.Encode(o); err != nil || !bytes.Equal(b, frame) {
+						t.Errorf("frame %v: re-encoded to %x, %v, want %x", i, b, err, frame)
+					}
+					if j, _ := o.MarshalJSON(); !bytes.Equal(j, lines[i]) {
+						t.Errorf("frame %v: got JSON %s, want %s", i, j, lines[i])
+					}
+
+					// invalid UTF-8 comes back from JSON as U+FFFD, which is written unescaped
+					fromJSON, err := c.DecodeJSON(lines[i])
+					if err != nil {
+						t.Fatalf("frame %v: %v", i, err)
+					}
+					if j, _ := fromJSON.MarshalJSON(); !sameJSON(j, lines[i]) {
+						t.Errorf("frame %v: got JSON %s from JSON, want %s", i, j, lines[i])
+					}
+				}
+				if i != len(lines) {
+					t.Errorf("got %v frames, want %v", i, len(lines))
+				}
+			})
+		}
+	}
+}
+
+func TestZeroJSON(t *testing.T) {
+	c, dir := goldenCodec(t, "little", 32)
+	lines := bytes.Split(bytes.TrimSuffix(readGolden(t, dir, "zero.json"), []byte("\n")), []byte("\n"))
+	if len(lines) != len(c.doc.Objects) {
+		t.Fatalf("got %v zero objects, want %v", len(lines), len(c.doc.Objects))
+	}
+	for i, obj := range c.doc.Objects {
+		if j, _ := New(obj).MarshalJSON(); !bytes.Equal(j, lines[i]) {
+			t.Errorf("%v: got JSON %s, want %s", obj.Name, j, lines[i])
+		}
+	}
+}
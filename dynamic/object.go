@@ -0,0 +1,530 @@
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/paidgeek/bufobjects/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// Object is a message held without generated code. Fields maps field names to values of these types:
+//
+//	bool, string, float32, float64
+//	int8, int16, int32, and int64 for int and int64 fields
+//	uint8 for byte and uint8 fields, uint16, uint32, and uint64 for uint and uint64 fields
+//	*Object for object fields
+//	[]interface{} for arrays and slices, holding the element types above
+//
+// Missing fields are written as zero values.
+type Object struct {
+	Schema *schema.Object
+	Fields map[string]interface{}
+}
+
+// New returns an empty object of the given schema object.
+func New(obj *schema.Object) *Object {
+	return &Object{Schema: obj, Fields: map[string]interface{}{}}
+}
+
+// FromValue converts a value decoded from JSON or YAML, laid out like the generated
+// MarshalJSON writes it, into an object. "_id" selects the object; fields are keyed by
+// their JSON names. JSON values should be decoded with json.Decoder.UseNumber.
+func (c *Codec) FromValue(v interface{}) (*Object, error) {
+	idValue, ok := lookupKey(v, "_id")
+	if !ok {
+		return nil, fmt.Errorf("missing _id")
+	}
+	id, err := toUint(idValue, 16)
+	if err != nil {
+		return nil, fmt.Errorf("_id: %v", err)
+	}
+	obj := c.doc.ObjectForId(uint16(id))
+	if obj == nil {
+		return nil, fmt.Errorf("%v: %v", ErrUnknownId, id)
+	}
+
+	return c.fromValue(obj, v, obj.Name)
+}
+
+// DecodeJSON decodes a JSON document written by the generated MarshalJSON.
+func (c *Codec) DecodeJSON(data []byte) (*Object, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return c.FromValue(v)
+}
+
+func (c *Codec) fromValue(obj *schema.Object, v interface{}, path string) (*Object, error) {
+	if idValue, ok := lookupKey(v, "_id"); ok {
+		if id, err := toUint(idValue, 16); err != nil || uint16(id) != obj.Id {
+			return nil, fmt.Errorf("%v: unexpected _id %v", path, idValue)
+		}
+	}
+
+	o := New(obj)
+	for _, f := range obj.Fields {
+		fv, ok := lookupKey(v, f.JSONName)
+		if !ok || fv == nil {
+			continue
+		}
+		p := path + "." + f.Name
+		if f.IsArray || f.IsSlice {
+			list, ok := fv.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%v: expected a list", p)
+			}
+			if f.IsArray && len(list) > f.ArraySize {
+				return nil, fmt.Errorf("%v: too many elements", p)
+			}
+			values := make([]interface{}, len(list))
+			for i, e := range list {
+				var err error
+				if values[i], err = c.elemFromValue(f.Type, e, indexPath(p, i)); err != nil {
+					return nil, err
+				}
+			}
+			o.Fields[f.Name] = values
+		} else {
+			var err error
+			if o.Fields[f.Name], err = c.elemFromValue(f.Type, fv, p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+func (c *Codec) elemFromValue(t string, v interface{}, path string) (interface{}, error) {
+	if schema.IsObjectType(t) {
+		if v == nil {
+			return nil, nil
+		}
+		return c.fromValue(c.doc.ObjectForType(t), v, path)
+	}
+
+	var x interface{}
+	var err error
+	switch t {
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			err = fmt.Errorf("expected a string, got %v", v)
+		}
+		x = s
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			err = fmt.Errorf("expected a bool, got %v", v)
+		}
+		x = b
+	case "float32":
+		var f float64
+		f, err = toFloat(v)
+		x = float32(f)
+	case "float64":
+		x, err = toFloat(v)
+	case "int", "int8", "int16", "int32", "int64":
+		var i int64
+		i, err = toInt(v, c.doc.SizeOf(t) * 8)
+		switch t {
+		case "int8":
+			x = int8(i)
+		case "int16":
+			x = int16(i)
+		case "int32":
+			x = int32(i)
+		default:
+			x = i
+		}
+	default:
+		var u uint64
+		u, err = toUint(v, c.doc.SizeOf(t) * 8)
+		switch t {
+		case "byte", "uint8":
+			x = uint8(u)
+		case "uint16":
+			x = uint16(u)
+		case "uint32":
+			x = uint32(u)
+		default:
+			x = u
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	return x, nil
+}
+
+// MarshalJSON writes the object the way the generated MarshalJSON writes it.
+func (o *Object) MarshalJSON() ([]byte, error) {
+	return o.appendJSON(nil), nil
+}
+
+func (o *Object) appendJSON(b []byte) []byte {
+	if o == nil {
+		return append(b, "null"...)
+	}
+	b = append(b, `{"_id":`...)
+	b = strconv.AppendUint(b, uint64(o.Schema.Id), 10)
+	for _, f := range o.Schema.Fields {
+		b = append(b, ',')
+		b = appendJSONString(b, f.JSONName)
+		b = append(b, ':')
+		v := o.Fields[f.Name]
+		if f.IsArray || f.IsSlice {
+			list, _ := v.([]interface{})
+			if f.IsSlice && list == nil {
+				b = append(b, "null"...)
+				continue
+			}
+			n := len(list)
+			if f.IsArray {
+				n = f.ArraySize
+			}
+			b = append(b, '[')
+			for i := 0; i < n; i++ {
+				if i > 0 {
+					b = append(b, ',')
+				}
+				var e interface{}
+				if i < len(list) {
+					e = list[i]
+				}
+				b = appendJSONValue(b, f.Type, e)
+			}
+			b = append(b, ']')
+		} else {
+			b = appendJSONValue(b, f.Type, v)
+		}
+	}
+
+	return append(b, '}')
+}
+
+func appendJSONValue(b []byte, t string, v interface{}) []byte {
+	if v == nil {
+		if schema.IsObjectType(t) {
+			return append(b, "null"...)
+		}
+		v = zero(t)
+	}
+
+	switch x := v.(type) {
+	case *Object:
+		return x.appendJSON(b)
+	case string:
+		return appendJSONString(b, x)
+	case bool:
+		return strconv.AppendBool(b, x)
+	case float32:
+		return appendJSONFloat(b, float64(x), 32)
+	case float64:
+		return appendJSONFloat(b, x, 64)
+	case int8, int16, int32, int64:
+		return strconv.AppendInt(b, toInt64(x), 10)
+	case uint8, uint16, uint32, uint64:
+		return strconv.AppendUint(b, toUint64(x), 10)
+	}
+
+	return append(b, "null"...)
+}
+
+// MarshalYAML returns the object as an ordered mapping with the same keys as MarshalJSON.
+func (o *Object) MarshalYAML() (interface{}, error) {
+	if o == nil {
+		return nil, nil
+	}
+	m := yaml.MapSlice{{Key: "_id", Value: o.Schema.Id}}
+	for _, f := range o.Schema.Fields {
+		v := o.Fields[f.Name]
+		if f.IsArray || f.IsSlice {
+			list, _ := v.([]interface{})
+			if f.IsSlice && list == nil {
+				m = append(m, yaml.MapItem{Key: f.JSONName, Value: nil})
+				continue
+			}
+			n := len(list)
+			if f.IsArray {
+				n = f.ArraySize
+			}
+			values := make([]interface{}, n)
+			for i := range values {
+				var e interface{}
+				if i < len(list) {
+					e = list[i]
+				}
+				values[i] = yamlValue(f.Type, e)
+			}
+			v = values
+		} else {
+			v = yamlValue(f.Type, v)
+		}
+		m = append(m, yaml.MapItem{Key: f.JSONName, Value: v})
+	}
+
+	return m, nil
+}
+
+func yamlValue(t string, v interface{}) interface{} {
+	if v == nil && !schema.IsObjectType(t) {
+		v = zero(t)
+	}
+	if f, ok := v.(float32); ok {
+		// widen through the shortest decimal, so 0.1 stays 0.1
+		v, _ = strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+	}
+	if f, ok := v.(float64); ok && f == 0 && math.Signbit(f) {
+		// YAML reads a plain -0 back as the integer 0, so it is written as a string, like NaN in JSON
+		return "-0"
+	}
+
+	return v
+}
+
+func zero(t string) interface{} {
+	switch t {
+	case "string":
+		return ""
+	case "bool":
+		return false
+	case "float32":
+		return float32(0)
+	case "float64":
+		return float64(0)
+	case "int8":
+		return int8(0)
+	case "int16":
+		return int16(0)
+	case "int32":
+		return int32(0)
+	case "int", "int64":
+		return int64(0)
+	case "byte", "uint8":
+		return uint8(0)
+	case "uint16":
+		return uint16(0)
+	case "uint32":
+		return uint32(0)
+	case "uint", "uint64":
+		return uint64(0)
+	}
+
+	return nil
+}
+
+// goType names the Go type Object uses for a schema type.
+func goType(t string) string {
+	switch t {
+	case "int":
+		return "int64"
+	case "uint":
+		return "uint64"
+	case "byte":
+		return "uint8"
+	}
+
+	return t
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// lookupKey finds key in an object decoded from JSON or YAML.
+func lookupKey(v interface{}, key string) (interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		x, ok := m[key]
+		return x, ok
+	case map[interface{}]interface{}:
+		x, ok := m[key]
+		return x, ok
+	case yaml.MapSlice:
+		for _, item := range m {
+			if item.Key == key {
+				return item.Value, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func toInt64(v interface{}) int64 {
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	}
+
+	return v.(int64)
+}
+
+func toUint64(v interface{}) uint64 {
+	switch x := v.(type) {
+	case uint8:
+		return uint64(x)
+	case uint16:
+		return uint64(x)
+	case uint32:
+		return uint64(x)
+	}
+
+	return v.(uint64)
+}
+
+func toInt(v interface{}, bits int) (int64, error) {
+	var i int64
+	var err error
+	switch x := v.(type) {
+	case json.Number:
+		i, err = strconv.ParseInt(string(x), 10, 64)
+	case string:
+		i, err = strconv.ParseInt(x, 10, 64)
+	case int:
+		i = int64(x)
+	case int64:
+		i = x
+	case uint64:
+		if x > math.MaxInt64 {
+			return 0, fmt.Errorf("%v is out of range", x)
+		}
+		i = int64(x)
+	case float64:
+		i = int64(x)
+		if float64(i) != x {
+			return 0, fmt.Errorf("expected an integer, got %v", x)
+		}
+	default:
+		return 0, fmt.Errorf("expected an integer, got %v", v)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("expected an integer, got %v", v)
+	}
+	if bits < 64 && (i < -1 << uint(bits - 1) || i >= 1 << uint(bits - 1)) {
+		return 0, fmt.Errorf("%v does not fit in %d bits", i, bits)
+	}
+
+	return i, nil
+}
+
+func toUint(v interface{}, bits int) (uint64, error) {
+	var u uint64
+	var err error
+	switch x := v.(type) {
+	case json.Number:
+		u, err = strconv.ParseUint(string(x), 10, 64)
+	case string:
+		u, err = strconv.ParseUint(x, 10, 64)
+	case int:
+		if x < 0 {
+			return 0, fmt.Errorf("%v is negative", x)
+		}
+		u = uint64(x)
+	case int64:
+		if x < 0 {
+			return 0, fmt.Errorf("%v is negative", x)
+		}
+		u = uint64(x)
+	case uint64:
+		u = x
+	case float64:
+		u = uint64(x)
+		if x < 0 || float64(u) != x {
+			return 0, fmt.Errorf("expected an unsigned integer, got %v", x)
+		}
+	default:
+		return 0, fmt.Errorf("expected an unsigned integer, got %v", v)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("expected an unsigned integer, got %v", v)
+	}
+	if bits < 64 && u >= 1 << uint(bits) {
+		return 0, fmt.Errorf("%v does not fit in %d bits", u, bits)
+	}
+
+	return u, nil
+}
+
+func toFloat(v interface{}) (float64, error) {
+	switch x := v.(type) {
+	case json.Number:
+		return strconv.ParseFloat(string(x), 64)
+	case string:
+		// NaN and infinities are strings in JSON
+		f, err := strconv.ParseFloat(x, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected a number, got %q", x)
+		}
+		return f, nil
+	case int:
+		return float64(x), nil
+	case int64:
+		return float64(x), nil
+	case uint64:
+		return float64(x), nil
+	case float64:
+		return x, nil
+	}
+
+	return 0, fmt.Errorf("expected a number, got %v", v)
+}
+
+func appendJSONFloat(b []byte, v float64, bits int) []byte {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		b = append(b, '"')
+		b = strconv.AppendFloat(b, v, 'g', -1, bits)
+		return append(b, '"')
+	}
+	return strconv.AppendFloat(b, v, 'g', -1, bits)
+}
+
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				b = append(b, `\ufffd`...)
+			} else {
+				b = append(b, s[i:i + size]...)
+			}
+			i += size
+			continue
+		}
+		switch {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c == '\n':
+			b = append(b, '\\', 'n')
+		case c == '\r':
+			b = append(b, '\\', 'r')
+		case c == '\t':
+			b = append(b, '\\', 't')
+		case c < 0x20:
+			b = append(b, '\\', 'u', '0', '0', hex[c >> 4], hex[c & 0xf])
+		default:
+			b = append(b, c)
+		}
+		i++
+	}
+
+	return append(b, '"')
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/paidgeek/bufobjects/dynamic"
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+// inspectNode is one annotated span of a frame: the frame itself, a header or a field.
+type inspectNode struct {
+	name     string
+	typ      string
+	off      int
+	len      int
+	value    string
+	problem  string
+	children []*inspectNode
+}
+
+type inspector struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func inspectMain(args []string) {
+	c := newSubcommand("inspect", "[file]")
+	order := c.load(args)
+
+	in := &inspector{data: c.input(), order: order}
+	if err := in.print(os.Stdout); err != nil {
+		os.Exit(1)
+	}
+}
+
+// print walks every frame in the data and writes the annotated tree to w.
+// It stops at the first truncated or unknown frame, since the framing is lost after it.
+func (in *inspector) print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "offset\tlength\tfield\ttype\tbytes\tvalue")
+
+	var err error
+	frames := 0
+	for off := 0; off < len(in.data) && err == nil; frames++ {
+		var n *inspectNode
+		n, off, err = in.frame(off)
+		n.name = fmt.Sprintf("frame %d", frames)
+		in.printNode(tw, n, 0)
+	}
+
+	tw.Flush()
+	fmt.Fprintf(w, "%d frames, %d bytes\n", frames, len(in.data))
+	if err != nil {
+		fmt.Fprintln(w, err)
+	}
+
+	return err
+}
+
+func (in *inspector) printNode(w io.Writer, n *inspectNode, depth int) {
+	end := n.off + n.len
+	raw := ""
+	if n.off < end {
+		if end - n.off > 8 {
+			raw = fmt.Sprintf("% x ...", in.data[n.off:n.off + 8])
+		} else {
+			raw = fmt.Sprintf("% x", in.data[n.off:end])
+		}
+	}
+
+	value := n.value
+	if n.problem != "" {
+		value += " <- " + n.problem
+	}
+
+	fmt.Fprintf(w, "%06x\t%d\t%s%s\t%s\t%s\t%s\n", n.off, n.len, strings.Repeat("  ", depth), n.name, n.typ, raw, value)
+	for _, c := range n.children {
+		in.printNode(w, c, depth + 1)
+	}
+}
+
+// frame decodes the frame at off and returns it with the offset of the next frame.
+func (in *inspector) frame(off int) (*inspectNode, int, error) {
+	n := &inspectNode{off: off}
+	idNode := &inspectNode{name: "id", typ: "uint16", off: off, len: 2}
+	n.children = append(n.children, idNode)
+
+	b, err := in.bytes(idNode, off, 2)
+	if err != nil {
+		n.len = len(in.data) - off
+		return n, len(in.data), err
+	}
+	id := uint16(in.uint(b))
+	idNode.value = strconv.Itoa(int(id))
+
+	obj := doc.ObjectForId(id)
+	if obj == nil {
+		idNode.problem = "unknown id"
+		n.len = len(in.data) - off
+		return n, len(in.data), dynamic.ErrUnknownId
+	}
+	n.typ = obj.Name
+	idNode.value += " " + obj.Name
+
+	end := off + 2
+	var sizeNode *inspectNode
+	size := 0
+	if obj.IsVariableSize {
+		sizeNode = &inspectNode{name: "size", typ: "uint16", off: end, len: 2}
+		n.children = append(n.children, sizeNode)
+		b, err = in.bytes(sizeNode, end, 2)
+		if err != nil {
+			n.len = len(in.data) - off
+			return n, len(in.data), err
+		}
+		size = int(in.uint(b))
+		sizeNode.value = strconv.Itoa(size)
+		end += 2
+	}
+
+	body := end
+	end, err = in.fields(n, obj, end)
+	n.len = end - off
+	if err == nil && sizeNode != nil && end - body != size {
+		sizeNode.problem = fmt.Sprintf("body is %d bytes", end - body)
+	}
+
+	return n, end, err
+}
+
+func (in *inspector) fields(parent *inspectNode, obj *schema.Object, off int) (int, error) {
+	for _, f := range obj.Fields {
+		n := &inspectNode{name: f.Name, typ: f.Type, off: off}
+		parent.children = append(parent.children, n)
+
+		var err error
+		if f.IsArray || f.IsSlice {
+			count := f.ArraySize
+			if f.IsArray {
+				n.typ = fmt.Sprintf("[%d]%s", f.ArraySize, f.Type)
+			} else {
+				n.typ = "[]" + f.Type
+				var b []byte
+				if b, err = in.bytes(n, off, 2); err == nil {
+					count = int(in.uint(b))
+					n.value = fmt.Sprintf("len %d", count)
+					off += 2
+				}
+			}
+			for i := 0; i < count && err == nil; i++ {
+				e := &inspectNode{name: fmt.Sprintf("[%d]", i), typ: f.Type, off: off}
+				n.children = append(n.children, e)
+				off, err = in.element(e, f.Type, off)
+				e.len = off - e.off
+			}
+		} else {
+			off, err = in.element(n, f.Type, off)
+		}
+
+		n.len = off - n.off
+		if err != nil {
+			return off, err
+		}
+	}
+
+	return off, nil
+}
+
+func (in *inspector) element(n *inspectNode, t string, off int) (int, error) {
+	if schema.IsObjectType(t) {
+		return in.fields(n, doc.ObjectForType(t), off)
+	}
+
+	if t == "string" {
+		b, err := in.bytes(n, off, 2)
+		if err != nil {
+			return off, err
+		}
+		l := int(in.uint(b))
+		if b, err = in.bytes(n, off + 2, l); err != nil {
+			return off, err
+		}
+		n.value = strconv.Quote(string(b))
+		return off + 2 + l, nil
+	}
+
+	size := baseSizeOf(&schema.Field{Type: t})
+	b, err := in.bytes(n, off, size)
+	if err != nil {
+		return off, err
+	}
+	n.value = formatScalar(t, in.uint(b), size)
+
+	return off + size, nil
+}
+
+func (in *inspector) bytes(n *inspectNode, off, size int) ([]byte, error) {
+	if off + size > len(in.data) {
+		n.problem = fmt.Sprintf("truncated: needs %d bytes, %d left", size, len(in.data) - off)
+		return nil, dynamic.ErrTruncated
+	}
+
+	return in.data[off:off + size], nil
+}
+
+func (in *inspector) uint(b []byte) uint64 {
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(in.order.Uint16(b))
+	case 4:
+		return uint64(in.order.Uint32(b))
+	}
+
+	return in.order.Uint64(b)
+}
+
+// formatScalar formats the raw bits of a primitive value the way the generated code decodes them.
+func formatScalar(t string, v uint64, size int) string {
+	switch t {
+	case "bool":
+		return strconv.FormatBool(v == 1)
+	case "float32":
+		return strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'g', -1, 32)
+	case "float64":
+		return strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+	case "int8":
+		return strconv.FormatInt(int64(int8(v)), 10)
+	case "int16":
+		return strconv.FormatInt(int64(int16(v)), 10)
+	case "int32":
+		return strconv.FormatInt(int64(int32(v)), 10)
+	case "int64":
+		return strconv.FormatInt(int64(v), 10)
+	case "int":
+		if size == 4 {
+			return strconv.FormatInt(int64(int32(v)), 10)
+		}
+		return strconv.FormatInt(int64(v), 10)
+	}
+
+	return strconv.FormatUint(v, 10)
+}
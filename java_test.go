@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestJavaGolden reads and writes the golden frames with the java target, and checks the
+// unsigned fields and BufObjects.newWithId on the way. Without javac and java it is skipped;
+// TestGolden still pins the bytes the java target has to match.
+func TestJavaGolden(t *testing.T) {
+	javac, err := exec.LookPath("javac")
+	if err != nil {
+		t.Skip("javac not found")
+	}
+	java, err := exec.LookPath("java")
+	if err != nil {
+		t.Skip("java not found")
+	}
+	check, err := ioutil.ReadFile(filepath.Join("testdata", "java", "Check.java"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			pkg := filepath.Join(dir, "golden")
+			if err := os.Mkdir(pkg, 0755); err != nil {
+				t.Fatal(err)
+			}
+			src := filepath.Join(pkg, "BufObjects.java")
+			generate(t, "-t", "java", "-i", "testdata/golden/schema.yaml", "-o", src, "-p", "golden", "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+			prog := filepath.Join(dir, "Check.java")
+			if err := ioutil.WriteFile(prog, check, 0644); err != nil {
+				t.Fatal(err)
+			}
+			out := filepath.Join(dir, "out")
+			if b, err := exec.Command(javac, "-encoding", "UTF-8", "-d", out, src, prog).CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, b)
+			}
+
+			golden := filepath.Join("testdata", "golden", name)
+			cmd := exec.Command(java, "-cp", out, "Check", filepath.Join(golden, "frames.bin"), filepath.Join(golden, "frames.json"))
+			if b, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, b)
+			}
+		})
+	}
+}
@@ -2,166 +2,24 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io/fs"
 	"os"
 	"log"
-	"io/ioutil"
 	"text/template"
-	"fmt"
-	"unicode"
 	"strings"
-	"strconv"
 	"bytes"
 	"errors"
-	"io"
+	"path"
 	"path/filepath"
-	"github.com/paidgeek/bufobjects/bindata"
-	"github.com/emirpasic/gods/sets"
-	"github.com/emirpasic/gods/sets/hashset"
-	"gopkg.in/yaml.v2"
-	"reflect"
-	"regexp"
-)
-
-type Field struct {
-	Name      string
-	CamelCase string
-	Type      string
-	ArraySize int
-	IsObject  bool
-	IsArray   bool
-	IsSlice   bool
-}
-
-type Object struct {
-	Id             uint16
-	Name           string
-	RawName        string
-	IsVariableSize bool
-	Fields         []*Field
-}
-
-type Document struct {
-	MaxObjectSize    int `json:"max_object_size"`
-	PackageName      string `json:"package_name"`
-	ObjectsImpl      string
-	ObjectNameSuffix string `json:"object_name_suffix"`
-	Objects          []*Object
-	Imports          []string `json:"imports"`
-	InterfaceName    string `json:"interface_name"`
-}
-
-var (
-	ErrTooManyObjects = errors.New("too many objects")
+	"github.com/paidgeek/bufobjects/plugin"
+	"github.com/paidgeek/bufobjects/schema"
 )
 
-var idCounter uint16
-var usedIds sets.Set
-var doc *Document
+var doc *schema.Document
 var typeTmpl *template.Template
 var mainBuf *bytes.Buffer
 
-func parseFile(file string, w io.Writer) error {
-	objects := []*Object{}
-	data, err := ioutil.ReadFile(file)
-	if err != nil {
-		return err
-	}
-	yamlData := &yaml.MapSlice{}
-	err = yaml.Unmarshal(data, yamlData)
-	if err != nil {
-		return err
-	}
-
-	for _, val := range *yamlData {
-		fields := []*Field{}
-		id := uint16(0)
-		key := val.Key.(string)
-
-		if reflect.ValueOf(val.Value).Kind() == reflect.Slice {
-			for _, fieldData := range val.Value.(yaml.MapSlice) {
-				fieldName := fmt.Sprintf("%v", fieldData.Key)
-				fieldType := fmt.Sprintf("%v", fieldData.Value)
-
-				if fieldName == "_id" {
-					parsedId, err := strconv.ParseUint(fieldType, 10, 16)
-					if err != nil {
-						return err
-					}
-					id = uint16(parsedId)
-					usedIds.Add(id)
-
-					continue
-				}
-
-				f := &Field{
-					Name:fieldName,
-					Type:fieldType,
-					CamelCase:fmt.Sprintf("%c%s", unicode.ToLower([]rune(fieldName)[0]), fieldName[1:]),
-				}
-				f.IsObject = isObject(f)
-				f.IsSlice = isSlice(f)
-				f.IsArray = isArray(f)
-				if f.IsArray {
-					f.ArraySize = arraySize(f)
-				}
-				f.Type = baseType(f)
-				fields = append(fields, f)
-			}
-
-			if id == 0 {
-				id, err = getNextId()
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			id, err = getNextId()
-			if err != nil {
-				return err
-			}
-		}
-
-		obj := &Object{
-			Id:id,
-			Name:key + doc.ObjectNameSuffix,
-			RawName:key,
-			Fields:fields,
-		}
-		objects = append(objects, obj)
-	}
-
-	doc.Objects = append(doc.Objects, objects...)
-	for _, obj := range doc.Objects {
-		obj.IsVariableSize = isVariableSize(obj)
-	}
-
-	if err := typeTmpl.ExecuteTemplate(w, "objects", objects); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func getNextId() (uint16, error) {
-	for idCounter++; idCounter < (1 << 16) - 1; idCounter++ {
-		if !usedIds.Contains(idCounter) {
-			return idCounter, nil
-		}
-	}
-
-	return 0, ErrTooManyObjects
-}
-
-func getObjectForType(t string) *Object {
-	for _, obj := range doc.Objects {
-		if obj.RawName == t {
-			return obj
-		}
-	}
-
-	return nil
-}
-
 func executeTmpl(name string, in interface{}) (string, error) {
 	buf := &bytes.Buffer{}
 	var ft *template.Template
@@ -174,16 +32,16 @@ func executeTmpl(name string, in interface{}) (string, error) {
 	return buf.String(), err
 }
 
-func write(f *Field) (string, error) {
+func write(f *schema.Field) (string, error) {
 	var t string
 
 	if f.IsObject && (f.IsArray || f.IsSlice) {
 		t = "object_indexed"
-	} else if isObject(f) {
+	} else if f.IsObject {
 		t = "object"
-	} else if isArray(f) {
+	} else if f.IsArray {
 		t = "array"
-	} else if isSlice(f) {
+	} else if f.IsSlice {
 		t = "slice"
 	} else {
 		t = f.Type
@@ -192,16 +50,16 @@ func write(f *Field) (string, error) {
 	return executeTmpl("write/write_" + t, f)
 }
 
-func read(f *Field) (string, error) {
+func read(f *schema.Field) (string, error) {
 	var t string
 
 	if f.IsObject && (f.IsArray || f.IsSlice) {
 		t = "object_indexed"
-	} else if isObject(f) {
+	} else if f.IsObject {
 		t = "object"
-	} else if isArray(f) {
+	} else if f.IsArray {
 		t = "array"
-	} else if isSlice(f) {
+	} else if f.IsSlice {
 		t = "slice"
 	} else {
 		t = f.Type
@@ -210,10 +68,10 @@ func read(f *Field) (string, error) {
 	return executeTmpl("read/read_" + t, f)
 }
 
-func writeArrayIndex(f *Field) (string, error) {
+func writeArrayIndex(f *schema.Field) (string, error) {
 	ai := typeTmpl.Lookup("array_index")
-	nf := &Field{}
-	nf.Type = arrayType(f.Type)
+	nf := &schema.Field{}
+	nf.Type = f.Type
 	buf := &bytes.Buffer{}
 	err := ai.Execute(buf, f)
 	if err != nil {
@@ -223,10 +81,19 @@ func writeArrayIndex(f *Field) (string, error) {
 	return write(nf)
 }
 
-func readArrayIndex(f *Field) (string, error) {
+// arrayElem returns a field standing for the i-th element of an array or slice field.
+func arrayElem(f *schema.Field) (*schema.Field, error) {
+	name, err := executeTmpl("array_index", f)
+	if err != nil {
+		return nil, err
+	}
+	return f.Elem(name), nil
+}
+
+func readArrayIndex(f *schema.Field) (string, error) {
 	ai := typeTmpl.Lookup("array_index")
-	nf := &Field{}
-	nf.Type = arrayType(f.Type)
+	nf := &schema.Field{}
+	nf.Type = f.Type
 	buf := &bytes.Buffer{}
 	err := ai.Execute(buf, f)
 	if err != nil {
@@ -238,27 +105,71 @@ func readArrayIndex(f *Field) (string, error) {
 
 var schemaFlag = flag.String("i", "", "schema files pattern")
 var outFlag = flag.String("o", "bufobjects_gen.go", "result file path")
-var langFlag = flag.String("t", "", "target language")
+var langFlag = flag.String("t", "", "target language (go, ts, c, python, rust, java or csharp)")
 var pkgFlag = flag.String("p", "main", "result package name")
 var interfaceNameFlag = flag.String("interface", "BufObject", "interface name")
 var suffixFlag = flag.String("name-suffix", "", "optional object name suffix")
+var maxLenFlag = flag.Uint("max-len", 32, "capacity of strings and slices without maxLen, for targets with fixed-size storage (c)")
 var maxSizeFlag = flag.Uint("max-size", 4096, "max object size (used as read/write buffer size)")
+var validateOnReadFlag = flag.Bool("validate-on-read", false, "validate objects in Read<Interface>From")
+var jsonInt64StringFlag = flag.Bool("json-int64-string", false, "write 64-bit integer fields as JSON strings")
+var endianFlag = flag.String("endian", "little", "byte order of the wire format (little or big)")
+var genBenchFlag = flag.Bool("gen-bench", false, "generate marshal and unmarshal benchmarks next to the result file")
+var genTestsFlag = flag.Bool("gen-tests", false, "generate round-trip tests and a fuzz target next to the result file")
+var intSizeFlag = flag.Uint("int-size", 32, "encoded size of int and uint fields in bits (32 or 64)")
+var noUnsafeFlag = flag.Bool("no-unsafe", false, "generate code without the unsafe package")
+var templatesFlag = flag.String("templates", "", "directory with <lang>/... templates that replace or extend the built-in ones")
+var pluginFlag = flag.String("plugin", "", "executable that generates the result files from the schema instead of -t")
+var pluginOptFlag = flag.String("plugin-opt", "", "parameter passed to the plugin as is")
+var positionalCtorFlag = flag.Bool("positional-ctor", true, "generate New<Object> constructors taking every field positionally")
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "inspect":
+			inspectMain(os.Args[2:])
+			return
+		case "encode":
+			encodeMain(os.Args[2:])
+			return
+		case "decode":
+			decodeMain(os.Args[2:])
+			return
+		case "check-templates":
+			checkTemplatesMain(os.Args[2:])
+			return
+		}
+	}
+
 	flag.Parse()
 	lang := *langFlag
 	pattern := *schemaFlag
 
-	if lang == "" {
+	if lang == "" && *pluginFlag == "" {
 		log.Fatalln("lang not set")
 		return
 	}
 
+	if lang != "" && *pluginFlag != "" {
+		log.Fatalln("-t and -plugin cannot be used together")
+		return
+	}
+
 	if pattern == "" {
 		log.Fatalln("schema files not set")
 		return
 	}
 
+	if *endianFlag != "little" && *endianFlag != "big" {
+		log.Fatalln("endian must be little or big")
+		return
+	}
+
+	if *intSizeFlag != 32 && *intSizeFlag != 64 {
+		log.Fatalln("int-size must be 32 or 64")
+		return
+	}
+
 	files, err := filepath.Glob(pattern)
 	if err != nil {
 		log.Fatalln(err)
@@ -270,184 +181,370 @@ func main() {
 		return
 	}
 
-	typeTmpl = template.New("type").Funcs(template.FuncMap{
-		"write":write,
-		"read":read,
-		"writeArrayIndex":writeArrayIndex,
-		"readArrayIndex":readArrayIndex,
-		"baseSizeOf":baseSizeOf,
-	})
+	doc = &schema.Document{
+		Objects:[]*schema.Object{},
+		PackageName:*pkgFlag,
+		InterfaceName:*interfaceNameFlag,
+		ObjectNameSuffix:*suffixFlag,
+		MaxObjectSize:int(*maxSizeFlag),
+		PositionalConstructors:*positionalCtorFlag,
+		ValidateOnRead:*validateOnReadFlag,
+		JSONInt64String:*jsonInt64StringFlag,
+		ByteOrder:*endianFlag,
+		NoUnsafe:*noUnsafeFlag,
+		IntSize:int(*intSizeFlag),
+		GenTests:*genTestsFlag,
+		GenBench:*genBenchFlag,
+		MaxLen:int(*maxLenFlag),
+	}
 
-	for _, n := range bindata.AssetNames() {
-		name := n[strings.IndexByte(n, '/') + 1:strings.LastIndexByte(n, '.')]
-		newTmpl, err := typeTmpl.New(name).Parse(string(bindata.MustAsset(n)))
+	if err = doc.ParseFiles(files); err != nil {
+		log.Fatalln(err)
+		return
+	}
+
+	if *pluginFlag != "" {
+		res, err := plugin.Run(*pluginFlag, plugin.NewRequest(doc, *outFlag, *pluginOptFlag))
 		if err != nil {
 			log.Fatalln(err)
 			return
 		}
-		typeTmpl, err = typeTmpl.AddParseTree(name, newTmpl.Tree)
-		if err != nil {
+		if err = res.WriteFiles(filepath.Dir(*outFlag)); err != nil {
 			log.Fatalln(err)
-			return
 		}
+		return
 	}
 
-	doc = &Document{
-		Objects:[]*Object{},
-		PackageName:*pkgFlag,
-		InterfaceName:*interfaceNameFlag,
-		ObjectNameSuffix:*suffixFlag,
-		MaxObjectSize:int(*maxSizeFlag),
+	if err = loadTarget(lang, *templatesFlag); err != nil {
+		log.Fatalln(err)
+		return
+	}
+
+	if typeTmpl.Lookup("doc") == nil {
+		log.Fatalf("unknown target language %v\n", lang)
+		return
+	}
+
+	if missing := missingTemplates(); len(missing) > 0 {
+		log.Fatalf("%v target is missing templates: %v\n", lang, strings.Join(missing, ", "))
+		return
+	}
+
+	if err = checkReserved(lang); err != nil {
+		log.Fatalln(err)
+		return
+	}
+
+	if (*genTestsFlag || *genBenchFlag) && typeTmpl.Lookup("test") == nil {
+		log.Fatalf("tests are not supported for %v\n", lang)
+		return
 	}
 
 	mainBuf = &bytes.Buffer{}
-	usedIds = hashset.New()
-	for _, f := range files {
-		if err = parseFile(f, mainBuf); err != nil {
+	if typeTmpl.Lookup("objects") != nil {
+		if err = typeTmpl.ExecuteTemplate(mainBuf, "objects", doc.Objects); err != nil {
 			log.Fatalln(err)
 			return
 		}
 	}
 
-	docTmpl, err := template.New("doc").Parse(string(bindata.MustAsset(lang + "/doc.tmpl")))
+	resFile, err := os.Create(*outFlag)
 	if err != nil {
 		log.Fatalln(err)
 		return
 	}
 
-	resFile, err := os.Create(*outFlag)
+	doc.ObjectsImpl = mainBuf.String()
+
+	err = typeTmpl.ExecuteTemplate(resFile, "doc", doc)
 	if err != nil {
 		log.Fatalln(err)
 		return
 	}
 
-	doc.ObjectsImpl = mainBuf.String()
-	if lang == "go" {
-		for _, obj := range doc.Objects {
-			for _, f := range obj.Fields {
-				if strings.Contains(f.Type, "float") {
-					doc.Imports = append(doc.Imports, "unsafe")
-					goto OUT
-				}
-			}
+	if typeTmpl.Lookup("header") != nil {
+		headerFile, err := os.Create(headerPath(*outFlag))
+		if err != nil {
+			log.Fatalln(err)
+			return
+		}
+		err = typeTmpl.ExecuteTemplate(headerFile, "header", doc)
+		if err != nil {
+			log.Fatalln(err)
+			return
 		}
 	}
-	OUT:
 
-	err = docTmpl.ExecuteTemplate(resFile, "doc", doc)
+	if doc.GenTests || doc.GenBench {
+		testFile, err := os.Create(strings.TrimSuffix(*outFlag, ".go") + "_test.go")
+		if err != nil {
+			log.Fatalln(err)
+			return
+		}
+		err = typeTmpl.ExecuteTemplate(testFile, "test", doc)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+}
+
+// addTemplate parses text as the named template, replacing any template of that name.
+func addTemplate(name string, text string) error {
+	newTmpl, err := typeTmpl.New(name).Parse(text)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
+	typeTmpl, err = typeTmpl.AddParseTree(name, newTmpl.Tree)
+	return err
 }
 
-// utils
+// templateFuncs returns the functions available to every template.
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"write":write,
+		"read":read,
+		"writeArrayIndex":writeArrayIndex,
+		"readArrayIndex":readArrayIndex,
+		"baseSizeOf":baseSizeOf,
+		"arrayElem":arrayElem,
+		"bitSizeOf":bitSizeOf,
+		"shift":shift,
+		"camel":camel,
+		"snake":snake,
+		"macro":macro,
+		"indent":indent,
+		"safe":safe,
+		"headerFile":func() string {
+			return filepath.Base(headerPath(*outFlag))
+		},
+		"objectName":func(t string) string {
+			return doc.ObjectForType(t).Name
+		},
+		"doc":func() *schema.Document {
+			return doc
+		},
+		"import":func(pkg string) string {
+			addImport(pkg)
+			return ""
+		},
+	}
+}
 
-func baseType(f *Field) string {
-	idx := strings.LastIndexByte(f.Type, ']')
-	t := f.Type
-	if idx > -1 {
-		t = f.Type[idx + 1:]
+// loadTarget replaces typeTmpl with the built-in templates of lang,
+// followed by the ones in dir/lang if dir is set.
+func loadTarget(lang string, dir string) error {
+	typeTmpl = template.New("type").Funcs(templateFuncs())
+	if err := loadTemplates(templates, lang); err != nil {
+		return err
+	}
+	if dir == "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return err
 	}
-	return t
+	if err := loadTemplates(os.DirFS(dir), lang); err != nil {
+		return fmt.Errorf("%v: %v", dir, err)
+	}
+	return nil
 }
 
-func isVariableSize(o *Object) bool {
-	for _, f := range o.Fields {
-		if f.Type == "string" || isSlice(f) {
-			return true
-		} else if isObject(f) {
-			obj := getObjectForType(f.Type)
-			if obj == nil {
-				log.Fatalf("%v not defined\n", f.Type)
-				os.Exit(1)
+// loadTemplates adds the templates in lang of fsys, named by their path without lang and the extension:
+// go/write/write_int.tmpl becomes "write/write_int", replacing any template loaded before under that name.
+func loadTemplates(fsys fs.FS, lang string) error {
+	if _, err := fs.Stat(fsys, lang); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return fs.WalkDir(fsys, lang, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".tmpl" {
+			return nil
+		}
+		text, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(p, lang + "/"), ".tmpl")
+		if err = addTemplate(name, string(text)); err != nil {
+			return fmt.Errorf("%v: %v", p, err)
+		}
+		return nil
+	})
+}
+
+// fieldKinds lists what read and write dispatch on besides the primitive types.
+var fieldKinds = []string{"string", "object", "object_indexed", "array", "slice"}
+
+// missingTemplates returns the read and write templates that typeTmpl lacks for a field kind.
+// A target without any of them, like a documentation-only one, needs none.
+func missingTemplates() []string {
+	missing := []string{}
+	found := false
+	for _, t := range append(append([]string{}, schema.PrimitiveTypes...), fieldKinds...) {
+		for _, name := range []string{"read/read_" + t, "write/write_" + t} {
+			if typeTmpl.Lookup(name) == nil {
+				missing = append(missing, name)
+			} else {
+				found = true
 			}
-			if isVariableSize(obj) {
-				return true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return missing
+}
+
+// checkReserved rejects fields named after a word in the target's "reserved" template,
+// like the methods the generated types have next to the field accessors, and object names
+// that make an identifier of the target's "identifiers" template appear twice.
+func checkReserved(lang string) error {
+	words, err := templateWords("reserved")
+	if err != nil {
+		return err
+	}
+	for _, obj := range doc.Objects {
+		for _, f := range obj.Fields {
+			for _, w := range words {
+				if f.Name == w {
+					return fmt.Errorf("%v.%v: field name is reserved by the %v target", obj.RawName, f.Name, lang)
+				}
 			}
 		}
 	}
 
-	return false
+	idents, err := templateWords("identifiers")
+	if err != nil {
+		return err
+	}
+	declared := map[string]bool{}
+	for _, id := range idents {
+		if declared[id] {
+			return fmt.Errorf("object names collide in the %v target: %v is declared twice", lang, id)
+		}
+		declared[id] = true
+	}
+	return nil
 }
 
-func baseSizeOf(f *Field) int {
-	var t = f.Type
-
-	if isArray(f) || isSlice(f) {
-		t = arrayType(t)
-	}
-
-	switch t {
-	case "bool":
-		return 1
-	case "byte":
-		return 1
-	case "int":
-		return 4
-	case "int8":
-		return 1
-	case "int16":
-		return 2
-	case "int32":
-		return 4
-	case "int64":
-		return 8
-	case "uint":
-		return 4
-	case "uint8":
-		return 1
-	case "uint16":
-		return 2
-	case "uint32":
-		return 4
-	case "uint64":
-		return 8
-	case "float32":
-		return 4
-	case "float64":
-		return 8
-	}
-
-	log.Fatalf("baseSizeOf: invalid type %v\n", t)
-	os.Exit(1)
-	return 0
+// utils
+
+func addImport(pkg string) {
+	for _, imp := range doc.Imports {
+		if imp == pkg {
+			return
+		}
+	}
+	doc.Imports = append(doc.Imports, pkg)
 }
 
-func isArray(f *Field) bool {
-	matched, err := regexp.MatchString("^\\[[0-9]", f.Type)
-	if err != nil {
-		log.Fatalln(err)
+func baseSizeOf(f *schema.Field) int {
+	n := doc.SizeOf(f.Type)
+	if n == 0 {
+		log.Fatalf("baseSizeOf: invalid type %v\n", f.Type)
 		os.Exit(1)
 	}
-	return matched
+	return n
 }
 
-func isObject(f *Field) bool {
-	return isObjectType(f.Type)
+// shift returns the bit shift of the i-th wire byte of an n-byte value.
+func shift(i, n int) int {
+	if doc.ByteOrder == "big" {
+		return (n - 1 - i) * 8
+	}
+	return i * 8
+}
+
+// camel lowers the first letter of a field name, or of a field expression like "Nums[i]".
+func camel(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// snake converts a field name, or a field expression like "Nums[i]", to snake case.
+func snake(name string) string {
+	b := []byte{}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 && (isLowerOrDigit(name[i - 1]) ||
+				name[i - 1] >= 'A' && name[i - 1] <= 'Z' && i + 1 < len(name) && name[i + 1] >= 'a' && name[i + 1] <= 'z') {
+				b = append(b, '_')
+			}
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+func isLowerOrDigit(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+// macro converts a name to an upper case identifier, e.g. "BufObject" to "BUF_OBJECT".
+func macro(name string) string {
+	b := []byte(strings.ToUpper(snake(name)))
+	for i, c := range b {
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// headerPath returns the header file written next to the result file by targets that have one.
+func headerPath(out string) string {
+	return strings.TrimSuffix(out, filepath.Ext(out)) + ".h"
 }
 
-func isObjectType(t string) bool {
-	idx := strings.LastIndexByte(t, ']')
-	if idx > -1 {
-		t = t[idx + 1:]
+// indent indents every line of generated code but the first by one more tab, or by
+// the given prefix: {{read . | indent "    "}}.
+func indent(args ...string) string {
+	prefix := "\t"
+	if len(args) > 1 {
+		prefix = args[0]
 	}
-	return unicode.IsUpper([]rune(t)[0])
+	return strings.Replace(args[len(args) - 1], "\n", "\n" + prefix, -1)
 }
 
-func isSlice(f *Field) bool {
-	return strings.HasPrefix(f.Type, "[]")
+// templateWords returns the whitespace-separated words of the named template, executed with the
+// schema document, or nil if the target has none.
+func templateWords(name string) ([]string, error) {
+	t := typeTmpl.Lookup(name)
+	if t == nil {
+		return nil, nil
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, doc); err != nil {
+		return nil, err
+	}
+	return strings.Fields(buf.String()), nil
 }
 
-func arraySize(f *Field) int {
-	t := f.Type
-	n, err := strconv.ParseInt(t[1:strings.IndexByte(t, ']')], 10, 32)
+// safe appends an underscore to identifiers listed in the target's "keywords" template.
+// Field expressions like "is[i]" are checked by their leading name.
+func safe(name string) (string, error) {
+	words, err := templateWords("keywords")
 	if err != nil {
-		log.Fatalln(err)
-		os.Exit(1)
+		return "", err
+	}
+	n := strings.IndexAny(name, "[.")
+	if n < 0 {
+		n = len(name)
+	}
+	for _, w := range words {
+		if w == name[:n] {
+			return name[:n] + "_" + name[n:], nil
+		}
 	}
-	return int(n)
+	return name, nil
 }
 
-func arrayType(t string) string {
-	return t[strings.LastIndexByte(t, ']') + 1:]
+func bitSizeOf(t string) int {
+	return baseSizeOf(&schema.Field{Type:t}) * 8
 }
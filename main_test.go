@@ -0,0 +1,381 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runMainEnv makes the test binary run the generator instead of the tests, so tests can call it
+// like a user would, flags and exit status included.
+const runMainEnv = "BUFOBJECTS_RUN_MAIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(runMainEnv) == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// bufobjects runs the command with args and stdin, and returns its stdout and stderr.
+func bufobjects(stdin []byte, args ...string) ([]byte, []byte, error) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), runMainEnv + "=1")
+	cmd.Stdin = bytes.NewReader(stdin)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+// generate runs the generator with args and fails the test with its output if it exits with an error.
+func generate(t *testing.T, args ...string) {
+	t.Helper()
+	if stdout, stderr, err := bufobjects(nil, args...); err != nil {
+		t.Fatalf("bufobjects %v: %v\n%s%s", args, err, stdout, stderr)
+	}
+}
+
+// generateError runs the generator with args and returns its output, failing the test if it succeeds.
+func generateError(t *testing.T, args ...string) string {
+	t.Helper()
+	stdout, stderr, err := bufobjects(nil, args...)
+	if err == nil {
+		t.Fatalf("bufobjects %v: no error", args)
+	}
+	return string(stdout) + string(stderr)
+}
+
+// goModule generates schema into the main package of a new module in a temporary directory,
+// copies files into it and returns the directory.
+func goModule(t *testing.T, schema string, args []string, files ...string) string {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module fixture\n\ngo 1.18\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	generate(t, append([]string{"-t", "go", "-p", "main", "-i", schema, "-o", filepath.Join(dir, "fixture.go")}, args...)...)
+
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, filepath.Base(f)), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// goCommand runs the go command in dir and returns its output, failing the test if it fails.
+func goCommand(t *testing.T, dir string, args ...string) []byte {
+	t.Helper()
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go %v: %v\n%s", args, err, out)
+	}
+	return out
+}
+
+func TestIntSize(t *testing.T) {
+	for _, size := range []string{"32", "64"} {
+		t.Run(size, func(t *testing.T) {
+			dir := goModule(t, "testdata/intsize/schema.yaml", []string{"-int-size", size},
+				"testdata/intsize/int" + size + "_test.go")
+			goCommand(t, dir, "vet", ".")
+			goCommand(t, dir, "test", ".")
+		})
+	}
+}
+
+// TestJSONInt64String checks that -json-int64-string quotes int and uint like int64 and uint64
+// when they are encoded in 64 bits.
+func TestJSONInt64String(t *testing.T) {
+	dir := goModule(t, "testdata/intsize/schema.yaml", []string{"-int-size", "64", "-json-int64-string"},
+		"testdata/intsize/json64_test.go")
+	goCommand(t, dir, "vet", ".")
+	goCommand(t, dir, "test", ".")
+}
+
+func TestRandom(t *testing.T) {
+	dir := goModule(t, "testdata/random/schema.yaml", nil, "testdata/random/random_test.go")
+	goCommand(t, dir, "vet", ".")
+	goCommand(t, dir, "test", ".")
+}
+
+// TestGeneratedTests runs the round-trip tests, fuzz seeds and benchmarks written by -gen-tests and -gen-bench.
+func TestGeneratedTests(t *testing.T) {
+	dir := goModule(t, "testdata/golden/schema.yaml", []string{"-gen-tests", "-gen-bench"})
+	goCommand(t, dir, "vet", ".")
+	goCommand(t, dir, "test", "-bench", ".", "-benchtime", "1x", ".")
+}
+
+var updateFlag = flag.Bool("update", false, "rewrite the golden files of TestGolden")
+
+// goldenConfigs are the byte orders and int sizes of the golden files in testdata/golden.
+// The tests of the dynamic package and of the other targets read them too.
+var goldenConfigs = []struct {
+	endian  string
+	intSize int
+}{
+	{"little", 32},
+	{"little", 64},
+	{"big", 32},
+	{"big", 64},
+}
+
+// TestGolden writes frames and JSON with generated Go code and compares them with the golden files.
+func TestGolden(t *testing.T) {
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := goModule(t, "testdata/golden/schema.yaml", []string{"-endian", c.endian, "-int-size", fmt.Sprint(c.intSize)},
+				"testdata/golden/main.go")
+			goCommand(t, dir, "vet", ".")
+			out := t.TempDir()
+			goCommand(t, dir, "run", ".", out)
+
+			golden := filepath.Join("testdata", "golden", name)
+			for _, file := range []string{"frames.bin", "frames.json", "zero.json"} {
+				got, err := ioutil.ReadFile(filepath.Join(out, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				path := filepath.Join(golden, file)
+				if *updateFlag {
+					if err = os.MkdirAll(golden, 0755); err != nil {
+						t.Fatal(err)
+					}
+					if err = ioutil.WriteFile(path, got, 0644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				want, err := ioutil.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%v differs from the generated code's output; run go test -run TestGolden -update if the change is intended", path)
+				}
+			}
+		})
+	}
+}
+
+func TestObjectNameCollisions(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "gen.go")
+	schema := func(yaml string) string {
+		path := filepath.Join(dir, "schema.yaml")
+		if err := ioutil.WriteFile(path, []byte(yaml), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	for yaml, ident := range map[string]string{
+		"ValidationError:\n  A: int32\n": "ValidationError",
+		"Hello:\n  A: int32\nHelloBuilder:\n  B: int32\n": "HelloBuilder",
+		"Default:\n  A: int32\nHello:\n  B: int32\nDefaultHello:\n  C: int32\n": "NewDefaultHello",
+		"Chars:\n  A: int32\n": "randomChars",
+		"Options:\n  A: int32\n": "RandomOptions",
+		"Descriptors:\n  A: int32\n": "Descriptors",
+		"FieldDescriptor:\n  A: int32\n": "FieldDescriptor",
+	} {
+		msg := generateError(t, "-t", "go", "-i", schema(yaml), "-o", out)
+		if !strings.Contains(msg, ident + " is declared twice") {
+			t.Errorf("%q: got %q, want a collision on %v", yaml, msg, ident)
+		}
+	}
+
+	msg := generateError(t, "-t", "go", "-i", schema("Hello:\n  A: int32\nTestHelloRoundTrip:\n  B: int32\n"), "-o", out, "-gen-tests")
+	if !strings.Contains(msg, "TestHelloRoundTrip is declared twice") {
+		t.Errorf("got %q, want a collision on TestHelloRoundTrip", msg)
+	}
+
+	// other targets and other suffixes keep their own namespaces
+	generate(t, "-t", "go", "-i", schema("Hello:\n  A: int32\nHelloBuilder:\n  B: int32\n"), "-o", out, "-name-suffix", "Msg")
+	generate(t, "-t", "ts", "-i", schema("ValidationError:\n  A: int32\n"), "-o", filepath.Join(dir, "gen.ts"))
+}
+
+// inspectRows runs inspect on data and returns its output lines with the columns separated by single spaces.
+func inspectRows(t *testing.T, data []byte, endian string, intSize int) ([]string, error) {
+	t.Helper()
+	stdout, _, err := bufobjects(data, "inspect", "-i", "testdata/golden/schema.yaml", "-endian", endian, "-int-size", fmt.Sprint(intSize))
+	rows := []string{}
+	for _, line := range strings.Split(strings.TrimSuffix(string(stdout), "\n"), "\n") {
+		rows = append(rows, strings.Join(strings.Fields(line), " "))
+	}
+	return rows, err
+}
+
+func containsRows(t *testing.T, rows []string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		if !containsString(rows, w) {
+			t.Errorf("missing row %q in\n%v", w, strings.Join(rows, "\n"))
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join("testdata", "golden", name)
+			data, err := ioutil.ReadFile(filepath.Join(dir, "frames.bin"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines, err := ioutil.ReadFile(filepath.Join(dir, "frames.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := inspectRows(t, data, c.endian, c.intSize)
+			if err != nil {
+				t.Fatalf("%v\n%v", err, strings.Join(rows, "\n"))
+			}
+			if rows[0] != "offset length field type bytes value" {
+				t.Errorf("got header %q", rows[0])
+			}
+
+			// frames follow each other without gaps and cover the whole input
+			frames, off := 0, 0
+			for _, row := range rows {
+				var o, n, i int
+				if _, err := fmt.Sscanf(row, "%x %d frame %d", &o, &n, &i); err != nil {
+					continue
+				}
+				if i != frames || o != off {
+					t.Errorf("got frame %v at %#x, want frame %v at %#x", i, o, frames, off)
+				}
+				frames++
+				off = o + n
+			}
+			if want := bytes.Count(lines, []byte("\n")); frames != want {
+				t.Errorf("got %v frames, want %v", frames, want)
+			}
+			if off != len(data) {
+				t.Errorf("frames end at %#x, want %#x", off, len(data))
+			}
+			if last := rows[len(rows) - 1]; last != fmt.Sprintf("%d frames, %d bytes", frames, len(data)) {
+				t.Errorf("got summary %q", last)
+			}
+		})
+	}
+
+	data, err := ioutil.ReadFile("testdata/golden/little-32/frames.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		rows, err := inspectRows(t, data[:0x31], "little", 32)
+		if err == nil {
+			t.Errorf("no error for a truncated frame")
+		}
+		containsRows(t, rows,
+			"000000 39 frame 0 Hello 01 00 23 00 17 00 68 c3 ...",
+			"000000 2 id uint16 01 00 1 Hello",
+			"000002 2 size uint16 23 00 35",
+			"000004 25 Text string 17 00 68 c3 a9 6c 6c 6f ... \"héllo \\\"世界\\\" 😀\\n\\t\\x01\"",
+			"00001d 8 Time int64 00 00 00 00 00 00 00 80 -9223372036854775808",
+			"000025 2 Code uint16 ff ff 65535",
+			"000027 4 frame 1 Hello 01 00 1f 00",
+			"000029 2 size uint16 1f 00 31",
+			"00002b 0 Text string <- truncated: needs 19 bytes, 4 left",
+			"2 frames, 49 bytes",
+			"truncated frame",
+		)
+	})
+
+	t.Run("unknown id", func(t *testing.T) {
+		rows, err := inspectRows(t, append(append([]byte{}, data[:0x27]...), 9, 0, 1, 2), "little", 32)
+		if err == nil {
+			t.Errorf("no error for an unknown id")
+		}
+		containsRows(t, rows,
+			"000027 4 frame 1 09 00 01 02",
+			"000027 2 id uint16 09 00 9 <- unknown id",
+			"2 frames, 43 bytes",
+			"unknown object id",
+		)
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		frame := append([]byte{}, data[:0x27]...)
+		frame[2]++
+		rows, err := inspectRows(t, frame, "little", 32)
+		if err != nil {
+			t.Errorf("got error %v for a wrong size, want only a flag", err)
+		}
+		containsRows(t, rows,
+			"000002 2 size uint16 24 00 36 <- body is 35 bytes",
+			"1 frames, 39 bytes",
+		)
+	})
+}
+
+// TestConvert decodes the golden frames to JSON and YAML with the decode subcommand and encodes
+// them back with the encode subcommand.
+func TestConvert(t *testing.T) {
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join("testdata", "golden", name)
+			frames, err := ioutil.ReadFile(filepath.Join(dir, "frames.bin"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines, err := ioutil.ReadFile(filepath.Join(dir, "frames.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			run := func(stdin []byte, args ...string) []byte {
+				t.Helper()
+				args = append(args, "-i", "testdata/golden/schema.yaml", "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+				stdout, stderr, err := bufobjects(stdin, args...)
+				if err != nil {
+					t.Fatalf("bufobjects %v: %v\n%s", args, err, stderr)
+				}
+				return stdout
+			}
+
+			j := run(frames, "decode", "-f", "json")
+			if !bytes.Equal(j, lines) {
+				t.Errorf("decode wrote JSON that differs from frames.json")
+			}
+			// JSON holds invalid UTF-8 as \ufffd, which is encoded as U+FFFD and then written unescaped,
+			// so that is the only change after a round trip
+			want := bytes.Replace(lines, []byte(`\ufffd`), []byte("\ufffd"), -1)
+			if again := run(run(j, "encode", "-f", "json"), "decode", "-f", "json"); !bytes.Equal(again, want) {
+				t.Errorf("JSON frames decode to\n%s\nwant\n%s", again, want)
+			}
+
+			// YAML keeps invalid UTF-8 as !!binary, so it encodes back to the same bytes
+			y := run(frames, "decode", "-f", "yaml")
+			if got := run(y, "encode", "-f", "yaml"); !bytes.Equal(got, frames) {
+				t.Errorf("YAML encoded to different frames:\n%s", y)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Package plugin defines the protocol between the generator and external generators. The generator
+// runs the plugin executable, writes a Request as JSON to its stdin and reads a Response as JSON
+// from its stdout. Plugins written in Go can use the types and ReadRequest and WriteResponse as is.
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+// Version is the protocol version sent in every request. It changes only when fields are
+// removed or change meaning; new fields may be added within a version.
+const Version = 1
+
+// Request is what the plugin reads from stdin.
+type Request struct {
+	Version   int       `json:"version"`
+	Output    string    `json:"output"` // -o as given on the command line
+	Parameter string    `json:"parameter"` // -plugin-opt as given on the command line
+	Document  *Document `json:"document"`
+}
+
+// Document is the resolved schema with the generator options that shape the wire format and naming.
+type Document struct {
+	PackageName      string `json:"package_name"`
+	InterfaceName    string `json:"interface_name"`
+	ObjectNameSuffix string `json:"object_name_suffix"`
+	ByteOrder        string `json:"byte_order"`
+	IntSize          int `json:"int_size"`
+	MaxObjectSize    int `json:"max_object_size"`
+	MaxLen           int `json:"max_len"`
+	Fingerprint      uint64 `json:"fingerprint,string"`
+	Objects          []*Object `json:"objects"` // in schema order
+	// DependencyOrder lists object ids so that every object comes after the objects its fields hold.
+	// It is empty if an object holds itself, directly or through others.
+	DependencyOrder []uint16 `json:"dependency_order"`
+}
+
+// Object is a schema object. Fields refer to other objects by RawName, never by value,
+// so recursive schemas serialize without cycles.
+type Object struct {
+	Id             uint16 `json:"id"`
+	Name           string `json:"name"` // with the name suffix
+	RawName        string `json:"raw_name"` // as written in the schema
+	IsVariableSize bool `json:"is_variable_size"`
+	Size           int `json:"size"` // body size of fixed-size objects, 0 for variable-size ones
+	Fields         []*Field `json:"fields"`
+}
+
+// Field is an object field. Type is the element type of arrays and slices.
+type Field struct {
+	Name       string `json:"name"`
+	CamelCase  string `json:"camel_case"`
+	JSONName   string `json:"json_name"`
+	Type       string `json:"type"`
+	SchemaType string `json:"schema_type"` // as written in the schema, e.g. "[4]Point"
+	ArraySize  int `json:"array_size"`
+	IsObject   bool `json:"is_object"`
+	IsArray    bool `json:"is_array"`
+	IsSlice    bool `json:"is_slice"`
+	// ElemSize is the encoded size of one element: the primitive size or the body size of a
+	// fixed-size object. It is 0 for strings and variable-size objects.
+	ElemSize   int `json:"elem_size"`
+	Default    string `json:"default,omitempty"` // Go literal, e.g. 42, "hi" or []int32{1, 2}
+	Min        string `json:"min,omitempty"`
+	Max        string `json:"max,omitempty"`
+	MaxLen     string `json:"max_len,omitempty"`
+	NonEmpty   bool `json:"non_empty,omitempty"`
+	Pattern    string `json:"pattern,omitempty"`
+	Required   bool `json:"required,omitempty"`
+}
+
+// Response is what the plugin writes to stdout. A plugin reports bad input through Error
+// and exits with status 0; a non-zero exit status is a plugin failure.
+type Response struct {
+	Error string  `json:"error,omitempty"`
+	Files []*File `json:"files"`
+}
+
+// File is a generated file. Name is a slash-separated path relative to the directory of the output.
+type File struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// NewRequest returns the request describing doc, which must be parsed.
+func NewRequest(doc *schema.Document, output string, parameter string) *Request {
+	d := &Document{
+		PackageName:doc.PackageName,
+		InterfaceName:doc.InterfaceName,
+		ObjectNameSuffix:doc.ObjectNameSuffix,
+		ByteOrder:doc.ByteOrder,
+		IntSize:doc.IntSize,
+		MaxObjectSize:doc.MaxObjectSize,
+		MaxLen:doc.MaxLen,
+		Fingerprint:doc.Fingerprint(),
+		Objects:[]*Object{},
+		DependencyOrder:[]uint16{},
+	}
+
+	sizes := map[*schema.Object]int{}
+	for _, obj := range doc.Objects {
+		o := &Object{
+			Id:obj.Id,
+			Name:obj.Name,
+			RawName:obj.RawName,
+			IsVariableSize:obj.IsVariableSize,
+			Fields:[]*Field{},
+		}
+		if !obj.IsVariableSize {
+			o.Size = bodySize(doc, obj, sizes)
+		}
+		for _, f := range obj.Fields {
+			o.Fields = append(o.Fields, &Field{
+				Name:f.Name,
+				CamelCase:f.CamelCase,
+				JSONName:f.JSONName,
+				Type:f.Type,
+				SchemaType:f.SchemaType(),
+				ArraySize:f.ArraySize,
+				IsObject:f.IsObject,
+				IsArray:f.IsArray,
+				IsSlice:f.IsSlice,
+				ElemSize:elemSize(doc, f, sizes),
+				Default:f.Default,
+				Min:f.Min,
+				Max:f.Max,
+				MaxLen:f.MaxLen,
+				NonEmpty:f.NonEmpty,
+				Pattern:f.Pattern,
+				Required:f.Required,
+			})
+		}
+		d.Objects = append(d.Objects, o)
+	}
+
+	if ordered, err := doc.DependencyOrder(); err == nil {
+		for _, obj := range ordered {
+			d.DependencyOrder = append(d.DependencyOrder, obj.Id)
+		}
+	}
+
+	return &Request{Version:Version, Output:output, Parameter:parameter, Document:d}
+}
+
+// elemSize returns the encoded size of one element of f, or 0 if it varies.
+func elemSize(doc *schema.Document, f *schema.Field, sizes map[*schema.Object]int) int {
+	if !f.IsObject {
+		return doc.SizeOf(f.Type)
+	}
+	obj := doc.ObjectForType(f.Type)
+	if obj == nil || obj.IsVariableSize {
+		return 0
+	}
+	return bodySize(doc, obj, sizes)
+}
+
+// bodySize returns the body size of a fixed-size object. Fixed-size objects cannot hold
+// themselves, so the recursion ends.
+func bodySize(doc *schema.Document, obj *schema.Object, sizes map[*schema.Object]int) int {
+	if n, ok := sizes[obj]; ok {
+		return n
+	}
+	n := 0
+	for _, f := range obj.Fields {
+		if f.IsArray {
+			n += f.ArraySize * elemSize(doc, f, sizes)
+		} else {
+			n += elemSize(doc, f, sizes)
+		}
+	}
+	sizes[obj] = n
+	return n
+}
+
+// Run starts the plugin at path, or found in PATH, sends it req and returns its response.
+// The plugin's stderr goes to the generator's stderr.
+func Run(path string, req *Request) (*Response, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &bytes.Buffer{}
+	cmd := exec.Command(path)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("plugin %v: %v", path, err)
+	}
+
+	res := &Response{}
+	if err = json.Unmarshal(out.Bytes(), res); err != nil {
+		return nil, fmt.Errorf("plugin %v: invalid response: %v", path, err)
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("plugin %v: %v", path, res.Error)
+	}
+
+	return res, nil
+}
+
+// WriteFiles writes the files of res to dir, creating directories as needed.
+// It rejects names that are absolute or lead out of dir.
+func (res *Response) WriteFiles(dir string) error {
+	for _, f := range res.Files {
+		name := filepath.Clean(filepath.FromSlash(f.Name))
+		if f.Name == "" || filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".." + string(filepath.Separator)) {
+			return fmt.Errorf("invalid file name '%v'", f.Name)
+		}
+	}
+
+	for _, f := range res.Files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, []byte(f.Content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadRequest reads the request a plugin gets on stdin, and checks its version.
+func ReadRequest(r io.Reader) (*Request, error) {
+	req := &Request{}
+	if err := json.NewDecoder(r).Decode(req); err != nil {
+		return nil, err
+	}
+	if req.Version != Version {
+		return nil, fmt.Errorf("unsupported protocol version %v", req.Version)
+	}
+	return req, nil
+}
+
+// WriteResponse writes the response a plugin returns on stdout.
+func WriteResponse(w io.Writer, res *Response) error {
+	return json.NewEncoder(w).Encode(res)
+}
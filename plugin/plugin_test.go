@@ -0,0 +1,163 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+// stubEnv makes the test binary act as a plugin that returns one file listing the objects
+// of the request, so Run can be tested against a real process.
+const stubEnv = "BUFOBJECTS_STUB_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(stubEnv) == "1" {
+		stub()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func stub() {
+	req, err := ReadRequest(os.Stdin)
+	if err != nil {
+		WriteResponse(os.Stdout, &Response{Error:err.Error()})
+		return
+	}
+	if req.Parameter == "fail" {
+		WriteResponse(os.Stdout, &Response{Error:"failed on request"})
+		return
+	}
+	names := []string{}
+	for _, obj := range req.Document.Objects {
+		names = append(names, obj.Name)
+	}
+	WriteResponse(os.Stdout, &Response{Files:[]*File{
+		{Name:"out/" + req.Parameter + ".txt", Content:strings.Join(names, " ") + "\n"},
+	}})
+}
+
+func parse(t *testing.T, yaml string) *schema.Document {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	if err := ioutil.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	doc := &schema.Document{ByteOrder:"little", IntSize:64}
+	if err := doc.ParseFiles([]string{path}); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestSizes(t *testing.T) {
+	req := NewRequest(parse(t, `
+Inner:
+  A: int32
+  B: uint16
+Outer:
+  In: Inner
+  Arr: "[3]Inner"
+  I: int
+Holder:
+  Name: string
+  Out: Outer
+  Outs: "[]Outer"
+`), "out.txt", "")
+
+	sizes := map[string]int{}
+	elems := map[string]int{}
+	for _, obj := range req.Document.Objects {
+		sizes[obj.Name] = obj.Size
+		for _, f := range obj.Fields {
+			elems[obj.Name + "." + f.Name] = f.ElemSize
+		}
+	}
+
+	for name, want := range map[string]int{"Inner": 6, "Outer": 6 + 3 * 6 + 8, "Holder": 0} {
+		if sizes[name] != want {
+			t.Errorf("%v: got size %v, want %v", name, sizes[name], want)
+		}
+	}
+	for name, want := range map[string]int{
+		"Inner.A": 4, "Inner.B": 2,
+		"Outer.In": 6, "Outer.Arr": 6, "Outer.I": 8,
+		"Holder.Name": 0, "Holder.Out": 32, "Holder.Outs": 32,
+	} {
+		if elems[name] != want {
+			t.Errorf("%v: got element size %v, want %v", name, elems[name], want)
+		}
+	}
+}
+
+func TestRecursiveDependencyOrder(t *testing.T) {
+	req := NewRequest(parse(t, `
+Node:
+  Value: int32
+  Children: "[]Node"
+`), "out.txt", "")
+
+	if order := req.Document.DependencyOrder; order == nil || len(order) != 0 {
+		t.Errorf("got dependency order %v, want an empty one", order)
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"", "../x", "a/../../x", "..", "/abs", filepath.Join(dir, "abs")} {
+		res := &Response{Files:[]*File{{Name:"ok.txt"}, {Name:name}}}
+		if err := res.WriteFiles(dir); err == nil {
+			t.Errorf("%q: no error", name)
+		}
+	}
+	if entries, _ := ioutil.ReadDir(dir); len(entries) > 0 {
+		t.Errorf("rejected responses wrote %v files", len(entries))
+	}
+
+	res := &Response{Files:[]*File{{Name:"a/b/c.txt", Content:"c"}, {Name:"./d.txt", Content:"d"}}}
+	if err := res.WriteFiles(dir); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"a/b/c.txt": "c", "d.txt": "d"} {
+		if data, err := ioutil.ReadFile(filepath.Join(dir, name)); err != nil || string(data) != want {
+			t.Errorf("%v: got %q, %v, want %q", name, data, err, want)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Setenv(stubEnv, "1")
+	doc := parse(t, `
+Hello:
+  Text: string
+Point:
+  X: float32
+`)
+
+	res, err := Run(os.Args[0], NewRequest(doc, "out.txt", "names"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Files) != 1 || res.Files[0].Name != "out/names.txt" || res.Files[0].Content != "Hello Point\n" {
+		t.Errorf("got files %+v", res.Files)
+	}
+
+	dir := t.TempDir()
+	if err = res.WriteFiles(dir); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "out", "names.txt")); err != nil || string(data) != "Hello Point\n" {
+		t.Errorf("got %q, %v", data, err)
+	}
+
+	if _, err = Run(os.Args[0], NewRequest(doc, "out.txt", "fail")); err == nil || !strings.Contains(err.Error(), "failed on request") {
+		t.Errorf("got error %v, want the plugin's error", err)
+	}
+	if _, err = Run(filepath.Join(t.TempDir(), "missing"), NewRequest(doc, "out.txt", "")); err == nil {
+		t.Errorf("no error for a missing plugin")
+	}
+}
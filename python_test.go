@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestPythonGolden reads and writes the golden frames with the python target. Without python3 it
+// is skipped; TestGolden still pins the bytes the python target has to match.
+func TestPythonGolden(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 not found")
+	}
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			py := filepath.Join(t.TempDir(), "golden.py")
+			generate(t, "-t", "python", "-i", "testdata/golden/schema.yaml", "-o", py, "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+
+			golden := filepath.Join("testdata", "golden", name)
+			cmd := exec.Command(python, filepath.Join("testdata", "python", "check.py"), py,
+				filepath.Join(golden, "frames.bin"), filepath.Join(golden, "frames.json"))
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
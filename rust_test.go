@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestRustGolden reads and writes the golden frames with the rust target. Without rustc it is
+// skipped; TestGolden still pins the bytes the rust target has to match.
+func TestRustGolden(t *testing.T) {
+	rustc, err := exec.LookPath("rustc")
+	if err != nil {
+		t.Skip("rustc not found")
+	}
+	check, err := ioutil.ReadFile(filepath.Join("testdata", "rust", "check.rs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			generate(t, "-t", "rust", "-i", "testdata/golden/schema.yaml", "-o", filepath.Join(dir, "golden.rs"), "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+			src := filepath.Join(dir, "check.rs")
+			if err := ioutil.WriteFile(src, check, 0644); err != nil {
+				t.Fatal(err)
+			}
+			bin := filepath.Join(dir, "check")
+			if out, err := exec.Command(rustc, "--edition", "2021", "-D", "warnings", "-o", bin, src).CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+
+			golden := filepath.Join("testdata", "golden", name)
+			cmd := exec.Command(bin, filepath.Join(golden, "frames.bin"), filepath.Join(golden, "frames.json"))
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
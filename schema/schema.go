@@ -0,0 +1,557 @@
+// Package schema holds the parsed form of bufobjects schema files, shared by the
+// code generator and the runtime tooling.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/hashset"
+	"gopkg.in/yaml.v2"
+)
+
+type Field struct {
+	Name      string
+	CamelCase string
+	Type      string
+	ArraySize int
+	IsObject  bool
+	IsArray   bool
+	IsSlice   bool
+	JSONName  string
+	Default   string
+	Min       string
+	Max       string
+	MaxLen    string
+	NonEmpty  bool
+	Pattern   string
+	PatternVar string
+	Required  bool
+
+	intSize int
+}
+
+// HasChecks reports whether Validate has anything to check for the field.
+func (f *Field) HasChecks() bool {
+	return f.IsObject || f.Min != "" || f.Max != "" || f.MaxLen != "" || f.NonEmpty || f.Pattern != "" || f.Required || f.HasRangeCheck()
+}
+
+// HasRangeCheck reports whether a platform int field must be checked to fit the encoded width.
+func (f *Field) HasRangeCheck() bool {
+	return f.intSize == 32 && (f.Type == "int" || f.Type == "uint")
+}
+
+// Elem returns a field standing for one element of an array or slice field, named name.
+func (f *Field) Elem(name string) *Field {
+	return &Field{
+		Name:name,
+		Type:f.Type,
+		IsObject:f.IsObject,
+		Min:f.Min,
+		Max:f.Max,
+		Pattern:f.Pattern,
+		PatternVar:f.PatternVar,
+		Required:f.Required,
+		intSize:f.intSize,
+	}
+}
+
+// SchemaType returns the field type as written in the schema, e.g. "[4]Point".
+func (f *Field) SchemaType() string {
+	if f.IsArray {
+		return "[" + strconv.Itoa(f.ArraySize) + "]" + f.Type
+	} else if f.IsSlice {
+		return "[]" + f.Type
+	}
+	return f.Type
+}
+
+type Object struct {
+	Id             uint16
+	Name           string
+	RawName        string
+	IsVariableSize bool
+	Fields         []*Field
+}
+
+type Document struct {
+	MaxObjectSize    int `json:"max_object_size"`
+	PackageName      string `json:"package_name"`
+	ObjectsImpl      string
+	ObjectNameSuffix string `json:"object_name_suffix"`
+	Objects          []*Object
+	Imports          []string `json:"imports"`
+	InterfaceName    string `json:"interface_name"`
+	PositionalConstructors bool `json:"positional_constructors"`
+	ValidateOnRead bool `json:"validate_on_read"`
+	JSONInt64String bool `json:"json_int64_string"`
+	ByteOrder      string `json:"byte_order"`
+	NoUnsafe       bool `json:"no_unsafe"`
+	IntSize        int `json:"int_size"`
+	GenTests       bool `json:"gen_tests"`
+	GenBench       bool `json:"gen_bench"`
+	MaxLen         int `json:"max_len"`
+
+	idCounter uint16
+	usedIds   sets.Set
+}
+
+var (
+	ErrTooManyObjects = errors.New("too many objects")
+)
+
+// ParseFiles parses the schema files into the document's objects. IntSize and
+// ObjectNameSuffix must be set beforehand.
+func (doc *Document) ParseFiles(files []string) error {
+	doc.usedIds = hashset.New()
+	for _, f := range files {
+		if err := doc.parseFile(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (doc *Document) parseFile(file string) error {
+	objects := []*Object{}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	yamlData := &yaml.MapSlice{}
+	err = yaml.Unmarshal(data, yamlData)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range *yamlData {
+		fields := []*Field{}
+		id := uint16(0)
+		key := val.Key.(string)
+
+		if reflect.ValueOf(val.Value).Kind() == reflect.Slice {
+			for _, fieldData := range val.Value.(yaml.MapSlice) {
+				fieldName := fmt.Sprintf("%v", fieldData.Key)
+				fieldType := fmt.Sprintf("%v", fieldData.Value)
+				var fieldOpts yaml.MapSlice
+
+				if opts, ok := fieldData.Value.(yaml.MapSlice); ok {
+					fieldType = ""
+					for _, opt := range opts {
+						if opt.Key == "type" {
+							fieldType = fmt.Sprintf("%v", opt.Value)
+						} else {
+							fieldOpts = append(fieldOpts, opt)
+						}
+					}
+					if fieldType == "" {
+						return fmt.Errorf("%v.%v: type not set", key, fieldName)
+					}
+				}
+
+				if fieldName == "_id" {
+					parsedId, err := strconv.ParseUint(fieldType, 10, 16)
+					if err != nil {
+						return err
+					}
+					id = uint16(parsedId)
+					doc.usedIds.Add(id)
+
+					continue
+				}
+
+				f := &Field{
+					Name:fieldName,
+					JSONName:fieldName,
+					Type:fieldType,
+					CamelCase:fmt.Sprintf("%c%s", unicode.ToLower([]rune(fieldName)[0]), fieldName[1:]),
+					intSize:doc.IntSize,
+				}
+				f.IsObject = IsObjectType(f.Type)
+				f.IsSlice = isSlice(f)
+				f.IsArray = isArray(f)
+				if f.IsArray {
+					if f.ArraySize, err = arraySize(f); err != nil {
+						return fmt.Errorf("%v.%v: %v", key, fieldName, err)
+					}
+				}
+				f.Type = baseType(f)
+				if err := doc.applyFieldOptions(f, fieldOpts, fields); err != nil {
+					return fmt.Errorf("%v.%v: %v", key, fieldName, err)
+				}
+				if f.Pattern != "" {
+					// the object index keeps "AB"."C" and "A"."BC" apart
+					f.PatternVar = fmt.Sprintf("pattern%d%v%v", len(doc.Objects) + len(objects), key, fieldName)
+				}
+				fields = append(fields, f)
+			}
+
+			if id == 0 {
+				id, err = doc.nextId()
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			id, err = doc.nextId()
+			if err != nil {
+				return err
+			}
+		}
+
+		obj := &Object{
+			Id:id,
+			Name:key + doc.ObjectNameSuffix,
+			RawName:key,
+			Fields:fields,
+		}
+		objects = append(objects, obj)
+	}
+
+	doc.Objects = append(doc.Objects, objects...)
+	for _, obj := range doc.Objects {
+		if obj.IsVariableSize, err = doc.isVariableSize(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (doc *Document) nextId() (uint16, error) {
+	for doc.idCounter++; doc.idCounter < (1 << 16) - 1; doc.idCounter++ {
+		if !doc.usedIds.Contains(doc.idCounter) {
+			return doc.idCounter, nil
+		}
+	}
+
+	return 0, ErrTooManyObjects
+}
+
+// ObjectForType returns the object a field type refers to, or nil.
+func (doc *Document) ObjectForType(t string) *Object {
+	for _, obj := range doc.Objects {
+		if obj.RawName == t {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+// ObjectForId returns the object with the given id, or nil.
+func (doc *Document) ObjectForId(id uint16) *Object {
+	for _, obj := range doc.Objects {
+		if obj.Id == id {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+// DependencyOrder returns the objects ordered so that every object comes after the objects
+// its fields hold, for targets that store nested objects by value.
+func (doc *Document) DependencyOrder() ([]*Object, error) {
+	ordered := []*Object{}
+	state := map[*Object]int{}
+	var visit func(obj *Object) error
+	visit = func(obj *Object) error {
+		switch state[obj] {
+		case 1:
+			return fmt.Errorf("%v: recursive objects are not supported by this target", obj.Name)
+		case 2:
+			return nil
+		}
+		state[obj] = 1
+		for _, f := range obj.Fields {
+			if !f.IsObject {
+				continue
+			}
+			dep := doc.ObjectForType(f.Type)
+			if dep == nil {
+				return fmt.Errorf("%v not defined", f.Type)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[obj] = 2
+		ordered = append(ordered, obj)
+		return nil
+	}
+	for _, obj := range doc.Objects {
+		if err := visit(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// primitiveSizes holds the encoded size of every fixed-size field type.
+// int and uint are 0 here, their size is IntSize.
+var primitiveSizes = []struct {
+	name string
+	size int
+}{
+	{"bool", 1}, {"byte", 1}, {"int8", 1}, {"uint8", 1},
+	{"int16", 2}, {"uint16", 2},
+	{"int32", 4}, {"uint32", 4}, {"float32", 4},
+	{"int64", 8}, {"uint64", 8}, {"float64", 8},
+	{"int", 0}, {"uint", 0},
+}
+
+// PrimitiveTypes lists the fixed-size field types, the ones SizeOf knows.
+var PrimitiveTypes = func() []string {
+	types := []string{}
+	for _, p := range primitiveSizes {
+		types = append(types, p.name)
+	}
+	return types
+}()
+
+// SizeOf returns the encoded size of a primitive type, or 0 for strings, objects and unknown types.
+func (doc *Document) SizeOf(t string) int {
+	for _, p := range primitiveSizes {
+		if p.name == t {
+			if p.size == 0 {
+				return doc.IntSize / 8
+			}
+			return p.size
+		}
+	}
+
+	return 0
+}
+
+// Fingerprint hashes everything that shapes the wire format: byte order, int size, and
+// every object's id and name with its field names and types, in order. Peers built from
+// schemas with equal fingerprints read each other's frames. JSON names, defaults and
+// validation options are not included.
+func (doc *Document) Fingerprint() uint64 {
+	order := doc.ByteOrder
+	if order == "" {
+		order = "little"
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%v %v\n", order, doc.IntSize)
+	for _, obj := range doc.Objects {
+		fmt.Fprintf(h, "%v %v\n", obj.Id, obj.RawName)
+		for _, f := range obj.Fields {
+			fmt.Fprintf(h, "\t%v %v\n", f.Name, f.SchemaType())
+		}
+	}
+
+	return h.Sum64()
+}
+
+func (doc *Document) isVariableSize(o *Object) (bool, error) {
+	for _, f := range o.Fields {
+		if f.Type == "string" || f.IsSlice {
+			return true, nil
+		} else if f.IsObject {
+			obj := doc.ObjectForType(f.Type)
+			if obj == nil {
+				return false, fmt.Errorf("%v not defined", f.Type)
+			}
+			if v, err := doc.isVariableSize(obj); v || err != nil {
+				return v, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// IsObjectType reports whether a field type refers to an object.
+func IsObjectType(t string) bool {
+	idx := strings.LastIndexByte(t, ']')
+	if idx > -1 {
+		t = t[idx + 1:]
+	}
+	return unicode.IsUpper([]rune(t)[0])
+}
+
+func baseType(f *Field) string {
+	idx := strings.LastIndexByte(f.Type, ']')
+	t := f.Type
+	if idx > -1 {
+		t = f.Type[idx + 1:]
+	}
+	return t
+}
+
+var arrayTypePattern = regexp.MustCompile("^\\[[0-9]")
+
+func isArray(f *Field) bool {
+	return arrayTypePattern.MatchString(f.Type)
+}
+
+func isSlice(f *Field) bool {
+	return strings.HasPrefix(f.Type, "[]")
+}
+
+func arraySize(f *Field) (int, error) {
+	t := f.Type
+	n, err := strconv.ParseInt(t[1:strings.IndexByte(t, ']')], 10, 32)
+	return int(n), err
+}
+
+// applyFieldOptions applies the default value and validation constraints declared
+// for a field, and checks its json name against the fields before it in prev.
+func (doc *Document) applyFieldOptions(f *Field, opts yaml.MapSlice, prev []*Field) error {
+	var err error
+	isString := f.Type == "string" && !f.IsObject
+	isNumber := !f.IsObject && f.Type != "string" && f.Type != "bool"
+	isSized := isString && !f.IsArray && !f.IsSlice || f.IsSlice
+
+	for _, opt := range opts {
+		switch opt.Key {
+		case "json":
+			f.JSONName = fmt.Sprintf("%v", opt.Value)
+		case "default":
+			f.Default, err = doc.defaultValue(f, opt.Value)
+		case "min", "max":
+			if !isNumber {
+				return fmt.Errorf("'%v' is only valid for numbers", opt.Key)
+			}
+			var lit string
+			lit, err = doc.scalarLiteral(f.Type, opt.Value)
+			if opt.Key == "min" {
+				f.Min = lit
+			} else {
+				f.Max = lit
+			}
+		case "maxLen":
+			if !isSized {
+				return errors.New("'maxLen' is only valid for strings and slices")
+			}
+			n, ok := opt.Value.(int)
+			if !ok || n < 0 {
+				return fmt.Errorf("invalid maxLen '%v'", opt.Value)
+			}
+			f.MaxLen = strconv.Itoa(n)
+		case "nonEmpty":
+			if !isSized {
+				return errors.New("'nonEmpty' is only valid for strings and slices")
+			}
+			f.NonEmpty, err = boolOption(opt)
+		case "pattern":
+			if !isString {
+				return errors.New("'pattern' is only valid for strings")
+			}
+			f.Pattern = fmt.Sprintf("%v", opt.Value)
+			_, err = regexp.Compile(f.Pattern)
+		case "required":
+			if !f.IsObject {
+				return errors.New("'required' is only valid for objects")
+			}
+			f.Required, err = boolOption(opt)
+		default:
+			return fmt.Errorf("unknown field option '%v'", opt.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.JSONName == "_id" {
+		return errors.New("json name '_id' is used for the object id")
+	}
+	for _, p := range prev {
+		if p.JSONName == f.JSONName {
+			return fmt.Errorf("json name '%v' is already used by %v", f.JSONName, p.Name)
+		}
+	}
+
+	return nil
+}
+
+func boolOption(opt yaml.MapItem) (bool, error) {
+	b, ok := opt.Value.(bool)
+	if !ok {
+		return false, fmt.Errorf("'%v' must be true or false", opt.Key)
+	}
+	return b, nil
+}
+
+// defaultValue converts a schema default into a Go literal for the field's type.
+func (doc *Document) defaultValue(f *Field, v interface{}) (string, error) {
+	if f.IsObject {
+		return "", errors.New("default values are not supported for object fields")
+	}
+
+	if f.IsArray || f.IsSlice {
+		items, ok := v.([]interface{})
+		if !ok {
+			return "", fmt.Errorf("default must be a list, got '%v'", v)
+		}
+		if f.IsArray && len(items) > f.ArraySize {
+			return "", fmt.Errorf("default has %v elements, array holds %v", len(items), f.ArraySize)
+		}
+
+		lits := make([]string, len(items))
+		for i, item := range items {
+			lit, err := doc.scalarLiteral(f.Type, item)
+			if err != nil {
+				return "", err
+			}
+			lits[i] = lit
+		}
+
+		prefix := "[]"
+		if f.IsArray {
+			prefix = fmt.Sprintf("[%d]", f.ArraySize)
+		}
+		return prefix + f.Type + "{" + strings.Join(lits, ", ") + "}", nil
+	}
+
+	return doc.scalarLiteral(f.Type, v)
+}
+
+func (doc *Document) scalarLiteral(t string, v interface{}) (string, error) {
+	s := fmt.Sprintf("%v", v)
+	bits := doc.SizeOf(t) * 8
+
+	switch t {
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("invalid bool value '%v'", v)
+		}
+		return strconv.FormatBool(b), nil
+	case "string":
+		return strconv.Quote(s), nil
+	case "byte", "uint", "uint8", "uint16", "uint32", "uint64":
+		n, err := strconv.ParseUint(s, 0, bits)
+		if err != nil {
+			return "", fmt.Errorf("invalid %v value '%v'", t, v)
+		}
+		return strconv.FormatUint(n, 10), nil
+	case "int", "int8", "int16", "int32", "int64":
+		n, err := strconv.ParseInt(s, 0, bits)
+		if err != nil {
+			return "", fmt.Errorf("invalid %v value '%v'", t, v)
+		}
+		return strconv.FormatInt(n, 10), nil
+	case "float32", "float64":
+		n, err := strconv.ParseFloat(s, bits)
+		if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+			return "", fmt.Errorf("invalid %v value '%v'", t, v)
+		}
+		return strconv.FormatFloat(n, 'g', -1, bits), nil
+	}
+
+	return "", fmt.Errorf("invalid type %v", t)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+// subcommand holds the flags shared by the commands that work on frames at runtime.
+type subcommand struct {
+	fs          *flag.FlagSet
+	schemaFlag  *string
+	endianFlag  *string
+	intSizeFlag *uint
+}
+
+func newSubcommand(name, usage string) *subcommand {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	c := &subcommand{
+		fs:fs,
+		schemaFlag:fs.String("i", "", "schema files pattern"),
+		endianFlag:fs.String("endian", "little", "byte order of the wire format (little or big)"),
+		intSizeFlag:fs.Uint("int-size", 32, "encoded size of int and uint fields in bits (32 or 64)"),
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: go-buffer-objects %v [options] %v\n", name, usage)
+		fs.PrintDefaults()
+	}
+
+	return c
+}
+
+// load parses the arguments and the schema files, and returns the byte order to use.
+func (c *subcommand) load(args []string) binary.ByteOrder {
+	c.fs.Parse(args)
+
+	if *c.schemaFlag == "" {
+		log.Fatalln("schema files not set")
+	}
+
+	order, err := byteOrder(*c.endianFlag)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *c.intSizeFlag != 32 && *c.intSizeFlag != 64 {
+		log.Fatalln("int-size must be 32 or 64")
+	}
+
+	files, err := filepath.Glob(*c.schemaFlag)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if len(files) == 0 {
+		log.Fatalln("no schema files found")
+	}
+
+	doc = &schema.Document{
+		Objects:[]*schema.Object{},
+		ByteOrder:*c.endianFlag,
+		IntSize:int(*c.intSizeFlag),
+	}
+
+	if err = doc.ParseFiles(files); err != nil {
+		log.Fatalln(err)
+	}
+
+	return order
+}
+
+// input reads the file named by the first argument, or stdin.
+func (c *subcommand) input() []byte {
+	var data []byte
+	var err error
+	if c.fs.NArg() > 0 {
+		data, err = ioutil.ReadFile(c.fs.Arg(0))
+	} else {
+		data, err = ioutil.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return data
+}
+
+func byteOrder(endian string) (binary.ByteOrder, error) {
+	switch endian {
+	case "little":
+		return binary.LittleEndian, nil
+	case "big":
+		return binary.BigEndian, nil
+	}
+
+	return nil, errors.New("endian must be little or big")
+}
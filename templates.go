@@ -0,0 +1,90 @@
+package main
+
+import (
+	"embed"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// templates holds the built-in templates of every target language, as <lang>/<name>.tmpl.
+//go:embed */*.tmpl */*/*.tmpl
+var templates embed.FS
+
+// checkTemplatesMain loads every target, built-in or in the -templates directory, and reports
+// the ones that do not parse or lack read and write templates for a field type.
+func checkTemplatesMain(args []string) {
+	fs := flag.NewFlagSet("check-templates", flag.ExitOnError)
+	dir := fs.String("templates", "", "directory with <lang>/... templates that replace or extend the built-in ones")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: go-buffer-objects check-templates [options]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	langs, err := targetLangs(*dir)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	failed := false
+	for _, lang := range langs {
+		if err := loadTarget(lang, *dir); err != nil {
+			fmt.Printf("%v: %v\n", lang, err)
+			failed = true
+			continue
+		}
+		if typeTmpl.Lookup("doc") == nil {
+			fmt.Printf("%v: missing template doc\n", lang)
+			failed = true
+			continue
+		}
+		if missing := missingTemplates(); len(missing) > 0 {
+			fmt.Printf("%v: missing templates %v\n", lang, strings.Join(missing, ", "))
+			failed = true
+			continue
+		}
+		fmt.Printf("%v: ok\n", lang)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// targetLangs returns the sorted names of the built-in targets and of the directories in dir.
+func targetLangs(dir string) ([]string, error) {
+	seen := map[string]bool{}
+	add := func(fsys fs.FS) error {
+		entries, err := fs.ReadDir(fsys, ".")
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				seen[e.Name()] = true
+			}
+		}
+		return nil
+	}
+
+	if err := add(templates); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := add(os.DirFS(dir)); err != nil {
+			return nil, err
+		}
+	}
+
+	langs := []string{}
+	for lang := range seen {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs, nil
+}
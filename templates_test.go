@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/paidgeek/bufobjects/schema"
+)
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEmbeddedTargets(t *testing.T) {
+	langs, err := targetLangs("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, lang := range []string{"go", "ts", "c", "python", "rust", "java", "csharp"} {
+		if !containsString(langs, lang) {
+			t.Errorf("target %v is not embedded", lang)
+		}
+	}
+
+	for _, lang := range langs {
+		if err := loadTarget(lang, ""); err != nil {
+			t.Errorf("%v: %v", lang, err)
+			continue
+		}
+		if typeTmpl.Lookup("doc") == nil {
+			t.Errorf("%v: missing template doc", lang)
+		}
+		if missing := missingTemplates(); len(missing) > 0 {
+			t.Errorf("%v: missing templates %v", lang, strings.Join(missing, ", "))
+		}
+	}
+}
+
+func TestMissingTemplates(t *testing.T) {
+	fsys := fstest.MapFS{
+		"x/doc.tmpl": {Data: []byte("{{.PackageName}}")},
+		"x/read/read_string.tmpl": {Data: []byte("read")},
+	}
+	typeTmpl = template.New("type").Funcs(templateFuncs())
+	if err := loadTemplates(fsys, "x"); err != nil {
+		t.Fatal(err)
+	}
+	missing := missingTemplates()
+	if len(missing) != 2 * (len(schema.PrimitiveTypes) + len(fieldKinds)) - 1 {
+		t.Errorf("got %v missing templates: %v", len(missing), missing)
+	}
+	for _, name := range []string{"read/read_int", "write/write_uint", "write/write_string"} {
+		if !containsString(missing, name) {
+			t.Errorf("%v is not reported missing", name)
+		}
+	}
+
+	// a target without read and write templates needs none
+	typeTmpl = template.New("type").Funcs(templateFuncs())
+	if err := loadTemplates(fstest.MapFS{"x/doc.tmpl": {Data: []byte("doc")}}, "x"); err != nil {
+		t.Fatal(err)
+	}
+	if missing := missingTemplates(); len(missing) > 0 {
+		t.Errorf("got missing templates %v for a target without any", missing)
+	}
+}
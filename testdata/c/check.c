@@ -0,0 +1,49 @@
+/* cc -std=c99 -I dir check.c dir/golden.c && ./a.out frames.bin
+ *
+ * Reads every frame of frames.bin with buf_object_read of golden.h, writes it back with
+ * buf_object_write and checks that the bytes are the same, then prints the number of frames.
+ */
+#include <stdio.h>
+#include <string.h>
+
+#include "golden.h"
+
+static uint8_t frames[1 << 16];
+static uint8_t buf[BUF_OBJECT_MAX_SIZE];
+static BufObject o;
+
+int main(int argc, char **argv) {
+	FILE *f;
+	size_t len, off;
+	int i, n, m;
+
+	if (argc != 2 || (f = fopen(argv[1], "rb")) == NULL) {
+		fprintf(stderr, "usage: check frames.bin\n");
+		return 2;
+	}
+	len = fread(frames, 1, sizeof frames, f);
+	fclose(f);
+	if (len == sizeof frames) {
+		fprintf(stderr, "frames.bin is too large\n");
+		return 2;
+	}
+
+	for (i = 0, off = 0; off < len; i++, off += (size_t)n) {
+		n = buf_object_read(&o, frames + off, len - off);
+		if (n < 0) {
+			fprintf(stderr, "frame %d: read error %d\n", i, n);
+			return 1;
+		}
+		m = buf_object_write(&o, buf, sizeof buf);
+		if (m != n) {
+			fprintf(stderr, "frame %d: wrote %d bytes, want %d\n", i, m, n);
+			return 1;
+		}
+		if (memcmp(buf, frames + off, (size_t)n) != 0) {
+			fprintf(stderr, "frame %d: wrote different bytes\n", i);
+			return 1;
+		}
+	}
+	printf("%d\n", i);
+	return 0;
+}
@@ -0,0 +1,88 @@
+// dotnet build check.csproj -o out && dotnet out/check.dll frames.bin frames.json,
+// with the module next to it in namespace Golden
+//
+// Reads every frame of frames.bin with BufObjects.ReadAt of the module, writes it back with
+// BufObjects.WriteAt and checks that the bytes are the same. Go wrote strings that are not
+// valid UTF-8 as \ufffd in frames.json, and the csharp target has to reject those frames with
+// a FormatException.
+using System;
+using System.Buffers.Binary;
+using System.IO;
+using System.Linq;
+using Golden;
+
+static class Check
+{
+    static int Fail(string msg)
+    {
+        Console.Error.WriteLine(msg);
+        return 1;
+    }
+
+    // FrameLength reads the length of the frame at the start of buf from its header.
+    static int FrameLength(ReadOnlySpan<byte> buf, BufObject o)
+    {
+        if (!o.IsVariableSize)
+        {
+            return 2 + o.Size();
+        }
+        var size = BufObjects.ByteOrder == "little"
+            ? BinaryPrimitives.ReadUInt16LittleEndian(buf.Slice(2))
+            : BinaryPrimitives.ReadUInt16BigEndian(buf.Slice(2));
+        return 4 + size;
+    }
+
+    static int Main(string[] args)
+    {
+        var frames = File.ReadAllBytes(args[0]);
+        var lines = File.ReadAllLines(args[1]).Where(l => l != "").ToArray();
+
+        var buf = new byte[BufObjects.MaxSize];
+        int off = 0;
+        int i = 0;
+        for (; off < frames.Length; i++)
+        {
+            if (i >= lines.Length)
+            {
+                return Fail("more frames than JSON lines");
+            }
+            var frame = frames.AsSpan(off);
+            var o = BufObjects.NewWithId(BufObjects.PeekId(frame));
+            if (o == null)
+            {
+                return Fail("frame " + i + ": unknown id " + BufObjects.PeekId(frame));
+            }
+            int length = FrameLength(frame, o);
+            off += length;
+
+            if (lines[i].Contains("\\ufffd"))
+            {
+                try
+                {
+                    BufObjects.ReadAt(frame, o);
+                }
+                catch (FormatException)
+                {
+                    continue;
+                }
+                return Fail("frame " + i + ": read invalid UTF-8");
+            }
+
+            int n = BufObjects.ReadAt(frame, o);
+            if (n != length)
+            {
+                return Fail("frame " + i + ": read " + n + " bytes, want " + length);
+            }
+            n = BufObjects.WriteAt(o, buf);
+            if (!buf.AsSpan(0, n).SequenceEqual(frame.Slice(0, length)))
+            {
+                return Fail("frame " + i + ": wrote " + Convert.ToHexString(buf, 0, n) + ", want " + Convert.ToHexString(frame.Slice(0, length)));
+            }
+        }
+        if (i != lines.Length)
+        {
+            return Fail("got " + i + " frames, want " + lines.Length);
+        }
+        return 0;
+    }
+}
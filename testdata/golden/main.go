@@ -0,0 +1,71 @@
+// Command main writes the golden files of TestGolden to the directory in its argument:
+// frames.bin holds frames written by the generated code, frames.json the JSON of each frame
+// as read back, one per line, and zero.json the JSON of every zero object in schema order.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := os.Args[1]
+	buf := make([]byte, MaxSize)
+	var frames, frameJSON, zeroJSON []byte
+
+	add := func(o BufObject) {
+		n := WriteBufObjectAt(o, buf)
+		frames = append(frames, buf[:n]...)
+		j, err := ReadBufObjectAt(buf[:n]).MarshalJSON()
+		if err != nil {
+			log.Fatal(err)
+		}
+		frameJSON = append(append(frameJSON, j...), '\n')
+	}
+
+	add(&Hello{Text: "héllo \"世界\" 😀\n\t\x01", Time: math.MinInt64, Code: math.MaxUint16})
+	add(&Hello{Text: "invalid \xff\xfe utf-8 \xe4\xb8", Time: math.MaxInt64})
+	add(&Point{
+		X: float32(math.Inf(-1)), Yv: math.Copysign(0, -1), Flag: true,
+		B: 255, I8: math.MinInt8, I16: math.MinInt16, I32: math.MinInt32,
+		U8: math.MaxUint8, U16: math.MaxUint16, U32: math.MaxUint32, U64: math.MaxUint64,
+		I: -1, U: math.MaxUint32, Is: []int{math.MinInt32, math.MaxInt32},
+	})
+	add(&Point{X: 0.1, Yv: math.SmallestNonzeroFloat64, Is: []int{}})
+	add(&Shape{Center: &Point{}, Corners: [4]*Point{{}, {X: 1}, {}, {Yv: 2}}})
+	add(&Shape{Name: "empty slices", Center: &Point{}, Corners: [4]*Point{{}, {}, {}, {}}, Pts: []*Point{}, Nums: []int32{}, Tags: []string{}})
+	add(&Empty{})
+
+	r := rand.New(rand.NewSource(11))
+	opts := &RandomOptions{MaxStringLen: 8, MaxSliceLen: 3, MaxDepth: 2}
+	for i := 0; i < 24; i++ {
+		switch i % 4 {
+		case 0:
+			add(RandomHello(r, opts))
+		case 1:
+			add(RandomPoint(r, opts))
+		case 2:
+			add(RandomShape(r, opts))
+		default:
+			add(RandomEmpty(r, opts))
+		}
+	}
+
+	for _, id := range []uint16{IdHello, IdPoint, IdShape, IdEmpty} {
+		j, err := NewBufObjectWithId(id).MarshalJSON()
+		if err != nil {
+			log.Fatal(err)
+		}
+		zeroJSON = append(append(zeroJSON, j...), '\n')
+	}
+
+	for name, data := range map[string][]byte{"frames.bin": frames, "frames.json": frameJSON, "zero.json": zeroJSON} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("int holds 32 bits")
+	}
+	big := int64(1) << 33
+
+	for _, c := range []struct {
+		obj  *Ints
+		path string
+	}{
+		{&Ints{I: int(big)}, "Ints.I"},
+		{&Ints{I: int(-big)}, "Ints.I"},
+		{&Ints{U: uint(big)}, "Ints.U"},
+		{&Ints{Is: []int{1, int(big)}}, "Ints.Is[1]"},
+		{&Ints{Us: [4]uint{0, 0, uint(big)}}, "Ints.Us[2]"},
+	} {
+		var verr *ValidationError
+		if err := c.obj.Validate(); !errors.As(err, &verr) || verr.Path != c.path {
+			t.Errorf("%v: got %v, want an error at %v", c.obj, err, c.path)
+		}
+	}
+
+	o := &Ints{I: -1 << 31, U: 1 << 32 - 1, Is: []int{1 << 31 - 1}}
+	if err := o.Validate(); err != nil {
+		t.Errorf("%v: %v", o, err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("int holds 32 bits")
+	}
+	big := int64(1) << 33
+
+	o := &Ints{I: int(big + 5), U: uint(big + 6), Is: []int{int(-big - 7)}}
+	buf := make([]byte, MaxSize)
+	n := WriteBufObjectAt(o, buf)
+	if want := 2 + 2 + 4 + 4 + 2 + 4 + 4 * 4; n != want {
+		t.Fatalf("wrote %v bytes, want %v", n, want)
+	}
+	got := ReadBufObjectAt(buf[:n]).(*Ints)
+	if got.I != 5 || got.U != 6 || len(got.Is) != 1 || got.Is[0] != -7 {
+		t.Errorf("got %v, want the low 32 bits of %v", got, o)
+	}
+}
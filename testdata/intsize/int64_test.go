@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("int holds 32 bits")
+	}
+	big := int64(1) << 33
+
+	o := &Ints{
+		I: int(-big),
+		U: uint(big),
+		Is: []int{int(big), -1, 0, int(-big - 1)},
+		Us: [4]uint{uint(big), 1 << 64 - 1},
+	}
+	if err := o.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, MaxSize)
+	n := WriteBufObjectAt(o, buf)
+	if want := 2 + 2 + 8 + 8 + 2 + 4 * 8 + 4 * 8; n != want {
+		t.Fatalf("wrote %v bytes, want %v", n, want)
+	}
+	if got := ReadBufObjectAt(buf[:n]); !o.Equal(got.(*Ints)) {
+		t.Errorf("got %v, want %v", got, o)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestJSONInt64String(t *testing.T) {
+	o := &Ints{
+		I: -1,
+		U: 1 << 40,
+		Is: []int{2, -3},
+		Us: [4]uint{4},
+	}
+	data, err := o.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"_id":1,"I":"-1","U":"1099511627776","Is":["2","-3"],"Us":["4","0","0","0"]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	got := &Ints{}
+	if err = got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !o.Equal(got) {
+		t.Errorf("got %v, want %v", got, o)
+	}
+}
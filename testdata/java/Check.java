@@ -0,0 +1,118 @@
+// javac -d out golden/BufObjects.java Check.java && java -cp out Check frames.bin frames.json
+//
+// Reads every frame of frames.bin with BufObjects.readAt of the golden package, writes it back
+// with writeAt and checks that the bytes are the same. Every object also has to come from
+// BufObjects.newWithId, and the unsigned fields, which use the next wider Java type, have to
+// hold the values the generated Go code wrote to frames.json.
+import golden.BufObjects;
+
+import java.nio.ByteBuffer;
+import java.nio.charset.StandardCharsets;
+import java.nio.file.Files;
+import java.nio.file.Paths;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.List;
+import java.util.regex.Matcher;
+import java.util.regex.Pattern;
+
+public class Check {
+    // What new String makes of the Hello text "invalid \xff\xfe utf-8 \xe4\xb8" in the golden
+    // frames: every invalid byte, and the truncated sequence at the end as a whole, decode to
+    // one U+FFFD. The Go JSON has a U+FFFD for each invalid byte instead.
+    static final String INVALID_TEXT = "invalid \uFFFD\uFFFD utf-8 \uFFFD";
+
+    static void fail(String msg) {
+        System.err.println(msg);
+        System.exit(1);
+    }
+
+    // field returns the integer the Go JSON line holds for key.
+    static String field(String line, String key) {
+        Matcher m = Pattern.compile("\"" + key + "\":(-?\\d+)").matcher(line);
+        if (!m.find()) {
+            fail("no " + key + " in " + line);
+        }
+        return m.group(1);
+    }
+
+    static void same(String path, String got, String want) {
+        if (!got.equals(want)) {
+            fail(path + ": got " + got + ", want " + want);
+        }
+    }
+
+    // reencoded returns the bytes a Hello frame whose text is not valid UTF-8 is written back as:
+    // the same frame, with the text encoded from its decoded form.
+    static byte[] reencoded(ByteBuffer frame, BufObjects.Hello o) {
+        byte[] text = o.text.getBytes(StandardCharsets.UTF_8);
+        int length = 4 + (frame.getShort(2) & 0xffff);
+        int rest = 6 + (frame.getShort(4) & 0xffff);
+        ByteBuffer want = ByteBuffer.allocate(6 + text.length + length - rest).order(BufObjects.BYTE_ORDER);
+        want.putShort(frame.getShort(0));
+        want.putShort((short) (want.capacity() - 4));
+        want.putShort((short) text.length);
+        want.put(text);
+        for (int j = rest; j < length; j++) {
+            want.put(frame.get(j));
+        }
+        return want.array();
+    }
+
+    public static void main(String[] args) throws Exception {
+        ByteBuffer frames = ByteBuffer.wrap(Files.readAllBytes(Paths.get(args[0]))).order(BufObjects.BYTE_ORDER);
+        List<String> lines = new ArrayList<>();
+        for (String line : Files.readAllLines(Paths.get(args[1]), StandardCharsets.UTF_8)) {
+            if (!line.isEmpty()) {
+                lines.add(line);
+            }
+        }
+
+        ByteBuffer buf = ByteBuffer.allocate(BufObjects.MAX_SIZE);
+        int i = 0;
+        for (; frames.hasRemaining(); i++) {
+            if (i >= lines.size()) {
+                fail("more frames than JSON lines");
+            }
+            String line = lines.get(i);
+            String name = "frame " + i;
+            ByteBuffer frame = frames.slice().order(BufObjects.BYTE_ORDER);
+            int start = frames.position();
+            BufObjects.BufObject o = BufObjects.readAt(frames);
+            byte[] want = Arrays.copyOfRange(frames.array(), start, frames.position());
+
+            BufObjects.BufObject fresh = BufObjects.newWithId(o.id());
+            if (fresh == null || fresh.getClass() != o.getClass()) {
+                fail(name + ": newWithId(" + o.id() + ") is " + fresh + ", want a " + o.getClass().getSimpleName());
+            }
+
+            if (line.contains("\\ufffd")) {
+                if (!(o instanceof BufObjects.Hello) || !((BufObjects.Hello) o).text.equals(INVALID_TEXT)) {
+                    fail(name + ": read " + o + ", want a Hello with text " + INVALID_TEXT);
+                }
+                want = reencoded(frame, (BufObjects.Hello) o);
+            }
+            if (o instanceof BufObjects.Hello) {
+                same(name + ".Code", String.valueOf(((BufObjects.Hello) o).code), field(line, "Code"));
+            }
+            if (o instanceof BufObjects.Point) {
+                BufObjects.Point p = (BufObjects.Point) o;
+                same(name + ".B", String.valueOf(p.b), field(line, "B"));
+                same(name + ".U8", String.valueOf(p.u8), field(line, "U8"));
+                same(name + ".U16", String.valueOf(p.u16), field(line, "U16"));
+                same(name + ".U32", String.valueOf(p.u32), field(line, "U32"));
+                same(name + ".U64", Long.toUnsignedString(p.u64), field(line, "U64"));
+                same(name + ".U", Long.toUnsignedString(p.u), field(line, "U"));
+            }
+
+            buf.clear();
+            int n = BufObjects.writeAt(o, buf);
+            if (!Arrays.equals(Arrays.copyOf(buf.array(), n), want)) {
+                fail(name + ": wrote " + n + " bytes that differ from the " + want.length + " bytes read");
+            }
+        }
+        if (i != lines.size()) {
+            fail("got " + i + " frames, want " + lines.size());
+        }
+    }
+}
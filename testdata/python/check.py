@@ -0,0 +1,101 @@
+"""python3 check.py module.py frames.bin frames.json
+
+Reads every frame of frames.bin with read_buf_object_at of the module, writes it back with
+write_buf_object_at and checks that the bytes are the same, then compares the fields with
+the JSON the generated Go code wrote for the frame.
+"""
+import importlib.util
+import json
+import keyword
+import math
+import struct
+import sys
+
+
+def load(path):
+    spec = importlib.util.spec_from_file_location("golden", path)
+    m = importlib.util.module_from_spec(spec)
+    sys.modules["golden"] = m
+    spec.loader.exec_module(m)
+    return m
+
+
+def snake(name):
+    out = ""
+    for i, c in enumerate(name):
+        if c.isupper() and i > 0 and (name[i - 1].islower() or name[i - 1].isdigit() or
+                                      name[i - 1].isupper() and i + 1 < len(name) and name[i + 1].islower()):
+            out += "_"
+        out += c.lower()
+    return out + "_" if keyword.iskeyword(out) else out
+
+
+def number(g):
+    return {"NaN": math.nan, "+Inf": math.inf, "-Inf": -math.inf}.get(g, g)
+
+
+def float32(x):
+    try:
+        return struct.unpack("f", struct.pack("f", x))[0]
+    except OverflowError:
+        return x
+
+
+def same(g, v, path):
+    """Compares a value from the Go JSON with the decoded one. Slices always decode as lists,
+    and float32 values are compared after rounding."""
+    if g is None:
+        if v is not None and v != []:
+            raise SystemExit("%s: got %r, want null" % (path, v))
+    elif isinstance(g, list):
+        if not isinstance(v, list) or len(v) != len(g):
+            raise SystemExit("%s: got %r, want %d elements" % (path, v, len(g)))
+        for i, (x, y) in enumerate(zip(g, v)):
+            same(x, y, "%s[%d]" % (path, i))
+    elif isinstance(g, dict):
+        for key, x in g.items():
+            if key != "_id":
+                same(x, getattr(v, snake(key)), path + "." + key)
+    elif isinstance(v, float):
+        g = float(number(g))
+        if not (g == v and math.copysign(1, g) == math.copysign(1, v) or
+                math.isnan(g) and math.isnan(v) or float32(g) == v):
+            raise SystemExit("%s: got %r, want %r" % (path, v, g))
+    elif g != v or type(g) != type(v):
+        raise SystemExit("%s: got %r, want %r" % (path, v, g))
+
+
+def main():
+    m = load(sys.argv[1])
+    frames = open(sys.argv[2], "rb").read()
+    lines = [l for l in open(sys.argv[3], encoding="utf-8").read().split("\n") if l]
+    uint16 = struct.Struct("<H" if m.BYTE_ORDER == "little" else ">H")
+
+    buf = bytearray(m.MAX_SIZE)
+    off = 0
+    for i, line in enumerate(lines):
+        if off >= len(frames):
+            raise SystemExit("got %d frames, want %d" % (i, len(lines)))
+        frame = memoryview(frames)[off:]
+        cls = m.OBJECTS[uint16.unpack_from(frame, 0)[0]]
+        length = 4 + uint16.unpack_from(frame, 2)[0] if cls.IS_VARIABLE_SIZE else 2 + cls().size()
+        off += length
+
+        # Go wrote strings that are not valid UTF-8 as \ufffd, and the python target rejects them
+        if "\\ufffd" in line:
+            try:
+                m.read_buf_object_at(frame)
+            except UnicodeDecodeError:
+                continue
+            raise SystemExit("frame %d: read invalid UTF-8" % i)
+
+        o = m.read_buf_object_at(frame)
+        n = m.write_buf_object_at(o, buf)
+        if bytes(buf[:n]) != bytes(frame[:length]):
+            raise SystemExit("frame %d: wrote %s, want %s" % (i, buf[:n].hex(), frame[:length].hex()))
+        same(json.loads(line), o, "frame %d" % i)
+    if off != len(frames):
+        raise SystemExit("more frames than JSON lines")
+
+
+main()
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMaxDepth(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for depth := 0; depth <= 3; depth++ {
+		opts := &RandomOptions{MinStringLen: 4, MaxStringLen: 8, MaxSliceLen: 4, MaxDepth: depth}
+		for i := 0; i < 20; i++ {
+			o := RandomRoot(r, opts)
+			for _, m := range []*Mid{o.Mid, o.Mids[0], o.Mids[1]} {
+				if m == nil || m.Leaf == nil {
+					t.Fatalf("depth %v: got nil objects in %v", depth, o)
+				}
+				// Mid is one level below Root and Leaf two
+				if (m.Leaves == nil) != (depth < 2) {
+					t.Errorf("depth %v: got Mid.Leaves %v", depth, m.Leaves)
+				}
+				if (m.Leaf.Name == "") != (depth < 2) {
+					t.Errorf("depth %v: got Leaf.Name %q", depth, m.Leaf.Name)
+				}
+				if (m.Leaf.Tags == nil) != (depth < 3) {
+					t.Errorf("depth %v: got Leaf.Tags %v", depth, m.Leaf.Tags)
+				}
+			}
+		}
+	}
+}
+
+func TestTooLarge(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RandomBig returned an object larger than MaxSize")
+		}
+	}()
+	RandomBig(rand.New(rand.NewSource(1)), nil)
+}
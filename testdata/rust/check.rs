@@ -0,0 +1,68 @@
+// rustc check.rs && ./check frames.bin frames.json, with the module next to it as golden.rs
+//
+// Reads every frame of frames.bin with read_buf_object_at of the module, writes it back with
+// write_buf_object_at and checks that the bytes are the same. Go wrote strings that are not
+// valid UTF-8 as \ufffd in frames.json, and the rust target has to reject those frames with
+// InvalidUtf8.
+#[allow(dead_code)]
+mod golden;
+
+use golden::*;
+use std::{env, fs, process};
+
+fn fail(msg: String) -> ! {
+    eprintln!("{}", msg);
+    process::exit(1)
+}
+
+// frame_length reads the length of the frame at the start of buf from its header.
+fn frame_length(buf: &[u8]) -> usize {
+    let u16_at = |off: usize| {
+        let b = [buf[off], buf[off + 1]];
+        (if BYTE_ORDER == "little" { u16::from_le_bytes(b) } else { u16::from_be_bytes(b) }) as usize
+    };
+    let o = BufObject::with_id(u16_at(0) as u16).unwrap_or_else(|| fail(format!("unknown id {}", u16_at(0))));
+    if o.is_variable_size() {
+        4 + u16_at(2)
+    } else {
+        2 + o.size()
+    }
+}
+
+fn main() {
+    let args: Vec<String> = env::args().collect();
+    let frames = fs::read(&args[1]).unwrap_or_else(|e| fail(e.to_string()));
+    let json = fs::read_to_string(&args[2]).unwrap_or_else(|e| fail(e.to_string()));
+    let lines: Vec<&str> = json.lines().filter(|l| !l.is_empty()).collect();
+
+    let mut buf = [0u8; MAX_SIZE];
+    let mut off = 0;
+    let mut i = 0;
+    while off < frames.len() {
+        let frame = &frames[off..];
+        let line = lines.get(i).unwrap_or_else(|| fail("more frames than JSON lines".to_string()));
+        if line.contains("\\ufffd") {
+            match read_buf_object_at(frame) {
+                Err(BufObjectError::InvalidUtf8) => {}
+                r => fail(format!("frame {}: got {:?}, want InvalidUtf8", i, r)),
+            }
+            off += frame_length(frame);
+            i += 1;
+            continue;
+        }
+
+        let (o, length) = read_buf_object_at(frame).unwrap_or_else(|e| fail(format!("frame {}: {}", i, e)));
+        if length != frame_length(frame) {
+            fail(format!("frame {}: read {} bytes, want {}", i, length, frame_length(frame)));
+        }
+        let n = write_buf_object_at(&o, &mut buf);
+        if buf[..n] != frame[..length] {
+            fail(format!("frame {}: wrote {:?}, want {:?}", i, &buf[..n], &frame[..length]));
+        }
+        off += length;
+        i += 1;
+    }
+    if i != lines.len() {
+        fail(format!("got {} frames, want {}", i, lines.len()));
+    }
+}
@@ -0,0 +1,132 @@
+// node check.mjs module.mjs frames.bin frames.json
+//
+// Reads every frame of frames.bin with readBufObjectAt of the module, writes it back with
+// writeBufObjectAt and checks that the bytes are the same, then compares the fields with
+// the JSON the generated Go code wrote for the frame. The text that is not valid UTF-8 is
+// checked against the replacement characters TextDecoder gives for it instead.
+import fs from "fs";
+import { pathToFileURL } from "url";
+
+const [modulePath, framesPath, jsonPath] = process.argv.slice(2);
+const m = await import(pathToFileURL(modulePath).href);
+const frames = new Uint8Array(fs.readFileSync(framesPath));
+const lines = fs.readFileSync(jsonPath, "utf8").split("\n").filter((l) => l !== "");
+
+// Integers are quoted before parsing, so 64-bit values keep their precision.
+function parseGoJSON(line) {
+	return JSON.parse(line.replace(/([:\[,])(-?\d+)(?=[,\]}])/g, '$1"$2"'));
+}
+
+function camel(name) {
+	return name.substring(0, 1).toLowerCase() + name.substring(1);
+}
+
+function number(s) {
+	switch (s) {
+	case "NaN": return NaN;
+	case "+Inf": return Infinity;
+	case "-Inf": return -Infinity;
+	}
+	return Number(s);
+}
+
+// same compares a value from the Go JSON with the decoded one. Slices always decode as arrays,
+// and float32 values are compared after rounding.
+function same(g, v, path) {
+	if (g === null) {
+		if (v !== null && !(Array.isArray(v) && v.length === 0)) {
+			throw new Error(path + ": got " + v + ", want null");
+		}
+		return;
+	}
+	if (Array.isArray(g)) {
+		if (!Array.isArray(v) || v.length !== g.length) {
+			throw new Error(path + ": got " + v + ", want " + g.length + " elements");
+		}
+		g.forEach((e, i) => same(e, v[i], path + "[" + i + "]"));
+		return;
+	}
+	if (typeof g === "object") {
+		for (const key of Object.keys(g)) {
+			if (key !== "_id") {
+				same(g[key], v[camel(key)], path + "." + key);
+			}
+		}
+		return;
+	}
+	let ok;
+	if (typeof v === "bigint") {
+		ok = BigInt(g) === v;
+	} else if (typeof v === "number") {
+		const n = number(g);
+		ok = Object.is(n, v) || Object.is(Math.fround(n), v);
+	} else {
+		ok = g === v;
+	}
+	if (!ok) {
+		throw new Error(path + ": got " + v + ", want " + g);
+	}
+}
+
+// invalidText is what TextDecoder makes of the Hello text "invalid \xff\xfe utf-8 \xe4\xb8" in
+// the golden frames: every invalid byte, and the truncated sequence at the end as a whole,
+// decode to one U+FFFD. The Go JSON has a U+FFFD for each invalid byte instead.
+const invalidText = "invalid \ufffd\ufffd utf-8 \ufffd";
+
+// reencoded returns the bytes a Hello frame whose text is not valid UTF-8 is written back as:
+// the same frame, with the text encoded from its decoded form.
+function reencoded(frame, o) {
+	const little = m.ByteOrder === "little";
+	const view = new DataView(frame.buffer, frame.byteOffset, frame.byteLength);
+	const text = new TextEncoder().encode(o.text);
+	const rest = frame.subarray(6 + view.getUint16(4, little), 4 + view.getUint16(2, little));
+	const want = new Uint8Array(6 + text.length + rest.length);
+	const w = new DataView(want.buffer);
+	want.set(frame.subarray(0, 2));
+	w.setUint16(2, want.length - 4, little);
+	w.setUint16(4, text.length, little);
+	want.set(text, 6);
+	want.set(rest, 6 + text.length);
+	return want;
+}
+
+function sameBytes(got, want, name) {
+	if (got.length !== want.length) {
+		throw new Error(name + ": wrote " + got.length + " bytes, want " + want.length);
+	}
+	for (let j = 0; j < got.length; j++) {
+		if (got[j] !== want[j]) {
+			throw new Error(name + ": byte " + j + " is " + got[j] + ", want " + want[j]);
+		}
+	}
+}
+
+const buf = new Uint8Array(m.MaxSize);
+let off = 0;
+let i = 0;
+for (; off < frames.length; i++) {
+	const frame = frames.subarray(off);
+	const o = m.readBufObjectAt(frame);
+	if (o === null) {
+		throw new Error("frame " + i + ": unknown id");
+	}
+	const view = new DataView(frame.buffer, frame.byteOffset, frame.byteLength);
+	const length = o.isVariableSize() ? 4 + view.getUint16(2, m.ByteOrder === "little") : 2 + o.size();
+	const n = m.writeBufObjectAt(o, buf);
+	off += length;
+
+	const g = parseGoJSON(lines[i]);
+	if (lines[i].includes("\\ufffd")) {
+		if (!(o instanceof m.Hello) || o.text !== invalidText) {
+			throw new Error("frame " + i + ": got text " + JSON.stringify(o.text) + ", want " + JSON.stringify(invalidText));
+		}
+		sameBytes(buf.subarray(0, n), reencoded(frame.subarray(0, length), o), "frame " + i);
+		g.Text = invalidText;
+	} else {
+		sameBytes(buf.subarray(0, n), frame.subarray(0, length), "frame " + i);
+	}
+	same(g, o, "frame " + i);
+}
+if (i !== lines.length) {
+	throw new Error("got " + i + " frames, want " + lines.length);
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// tsRunner returns how TestTypeScriptGolden turns the ts target into a module node can import:
+// tsc type checks it in strict mode and compiles it, and a node that strips types imports it
+// as it is. It skips the test when neither is there.
+func tsRunner(t *testing.T) (node, tsc string, nodeArgs []string) {
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node not found")
+	}
+	if tsc, err = exec.LookPath("tsc"); err == nil {
+		return node, tsc, nil
+	}
+	if exec.Command(node, "--experimental-strip-types", "-e", "0").Run() == nil {
+		return node, "", []string{"--experimental-strip-types", "--no-warnings"}
+	}
+	t.Skip("tsc not found and node cannot strip types")
+	return
+}
+
+// TestTypeScriptGolden reads and writes the golden frames with the ts target. Without node and
+// either tsc or a node that strips types it is skipped; TestGolden still pins the bytes the ts
+// target has to match.
+func TestTypeScriptGolden(t *testing.T) {
+	node, tsc, nodeArgs := tsRunner(t)
+
+	for _, c := range goldenConfigs {
+		name := fmt.Sprintf("%v-%v", c.endian, c.intSize)
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			module := filepath.Join(dir, "golden.mts")
+			generate(t, "-t", "ts", "-i", "testdata/golden/schema.yaml", "-o", module, "-endian", c.endian, "-int-size", fmt.Sprint(c.intSize))
+			if tsc != "" {
+				cmd := exec.Command(tsc, "--strict", "--target", "es2020", "--module", "es2020", module)
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("%v\n%s", err, out)
+				}
+				module = filepath.Join(dir, "golden.mjs")
+			}
+
+			golden := filepath.Join("testdata", "golden", name)
+			args := append(nodeArgs, filepath.Join("testdata", "ts", "check.mjs"), module,
+				filepath.Join(golden, "frames.bin"), filepath.Join(golden, "frames.json"))
+			if out, err := exec.Command(node, args...).CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}